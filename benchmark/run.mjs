@@ -1008,7 +1008,11 @@ async function runHolonRealTask({ runnerConfig, prompt, worktreePath, taskDir, r
     shellCommands: parsed?.shell_commands ?? 0,
     execCommandItems: parsed?.exec_command_items ?? 0,
     batchedExecCommandItems: parsed?.batched_exec_command_items ?? 0,
-    timedOut: Boolean(run.timedOut || parsed?.final_status === "max_turns_exceeded"),
+    timedOut: Boolean(
+      run.timedOut ||
+        parsed?.final_status === "max_turns_exceeded" ||
+        parsed?.final_status === "max_duration_exceeded",
+    ),
     errorKind:
       completion.terminal_state === "terminal"
         ? parsed?.final_status && parsed.final_status !== "completed"
@@ -7,7 +7,7 @@ use std::path::PathBuf;
 
 use clap::{Args, CommandFactory, Parser, Subcommand, ValueEnum};
 
-use crate::types::AuthorityClass;
+use crate::{daemon::DaemonLogLevel, types::AuthorityClass};
 
 fn parse_positive_usize(value: &str) -> Result<usize, String> {
     match value.parse::<usize>() {
@@ -132,6 +132,8 @@ pub enum Commands {
         template: Option<String>,
         #[arg(long, value_parser = clap::value_parser!(u64).range(1..))]
         max_turns: Option<u64>,
+        #[arg(long, value_parser = clap::value_parser!(u64).range(1..))]
+        max_duration_ms: Option<u64>,
         #[arg(long)]
         no_wait_for_tasks: bool,
         #[arg(long)]
@@ -160,6 +162,8 @@ pub enum Commands {
         model: Option<String>,
         #[arg(long, value_parser = clap::value_parser!(u64).range(1..))]
         max_turns: Option<u64>,
+        #[arg(long, value_parser = clap::value_parser!(u64).range(1..))]
+        max_duration_ms: Option<u64>,
         #[arg(
             long = "authority-class",
             alias = "trust",
@@ -275,6 +279,10 @@ pub enum DaemonCommands {
     Logs {
         #[arg(long, default_value_t = 80)]
         tail: usize,
+        #[arg(long, value_name = "TEXT")]
+        grep: Option<String>,
+        #[arg(long, value_enum)]
+        level: Option<DaemonLogLevel>,
     },
 }
 
@@ -566,6 +574,11 @@ pub enum DebugCommands {
         #[arg(long)]
         json: bool,
     },
+    #[command(
+        about = "Show or replace the running daemon's log filter",
+        long_about = "Show the running daemon's tracing filter, or replace it when FILTER is given.\n\nFILTER uses `RUST_LOG` syntax, for example `debug` or `holon=debug,info`. The change lasts until the daemon restarts."
+    )]
+    LogFilter { filter: Option<String> },
     RuntimeDb {
         #[command(subcommand)]
         command: RuntimeDbDebugCommands,
@@ -16,7 +16,8 @@ use crate::{
         BatchGetTranscriptEntriesResponse, ClearAgentModelRequest, ControlPromptRequest,
         CreateAgentRequest, DebugPromptRequest, DetachWorkspaceRequest, ExitWorkspaceRequest,
         ModelConfigMigrationRequest, RuntimeConfigReadResponse, RuntimeConfigUpdateRequest,
-        RuntimeConfigUpdateResponse, SetAgentModelRequest, TaskInputRequest, TaskStopRequest,
+        RuntimeConfigUpdateResponse, RuntimeLogFilterResponse, RuntimeLogFilterUpdateRequest,
+        SetAgentModelRequest, TaskInputRequest, TaskStopRequest,
     },
     http_dto::AgentStateSnapshotDto,
     model_catalog::BuiltInModelMetadata,
@@ -423,6 +424,23 @@ impl LocalClient {
         self.get_control_json("/control/runtime/performance").await
     }
 
+    pub async fn runtime_log_filter(&self) -> Result<RuntimeLogFilterResponse> {
+        self.get_control_json("/control/runtime/log-filter").await
+    }
+
+    pub async fn update_runtime_log_filter(
+        &self,
+        filter: &str,
+    ) -> Result<RuntimeLogFilterResponse> {
+        self.patch_control_json(
+            "/control/runtime/log-filter",
+            &RuntimeLogFilterUpdateRequest {
+                filter: filter.to_string(),
+            },
+        )
+        .await
+    }
+
     pub async fn update_runtime_config(
         &self,
         request: &RuntimeConfigUpdateRequest,
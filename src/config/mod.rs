@@ -60,6 +60,8 @@ pub struct AppConfig {
     pub max_relevant_episodes: usize,
     pub control_token: Option<String>,
     pub control_auth_mode: ControlAuthMode,
+    /// Shared secret for `X-Hub-Signature-256` checks on the generic webhook.
+    pub webhook_secret: Option<String>,
     pub api_cors: ApiCorsConfigFile,
     pub config_file_path: PathBuf,
     pub stored_config: HolonConfigFile,
@@ -128,6 +130,7 @@ impl AppConfig {
         reloaded.max_relevant_episodes = self.max_relevant_episodes;
         reloaded.control_token = self.control_token.clone();
         reloaded.control_auth_mode = self.control_auth_mode;
+        reloaded.webhook_secret = self.webhook_secret.clone();
         reloaded.config_file_path = self.config_file_path.clone();
         Ok(reloaded)
     }
@@ -225,6 +228,9 @@ impl AppConfig {
             .map(|value| ControlAuthMode::parse(&value))
             .transpose()?
             .unwrap_or(ControlAuthMode::Auto);
+        let webhook_secret = env::var("HOLON_WEBHOOK_SECRET")
+            .ok()
+            .filter(|value| !value.trim().is_empty());
         let runtime_max_output_tokens = env::var("HOLON_MAX_OUTPUT_TOKENS")
             .ok()
             .and_then(|value| value.parse::<u32>().ok())
@@ -323,6 +329,7 @@ impl AppConfig {
             max_relevant_episodes,
             control_token,
             control_auth_mode,
+            webhook_secret,
             api_cors: stored_config.api.cors.clone(),
             config_file_path,
             stored_config,
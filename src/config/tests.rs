@@ -152,6 +152,7 @@ fn test_app_config(default_model: &str, fallback_models: &[&str]) -> TestAppConf
         max_relevant_episodes: 3,
         control_token: Some("control-value".into()),
         control_auth_mode: ControlAuthMode::Auto,
+        webhook_secret: None,
         api_cors: Default::default(),
         config_file_path: home_path.join("config.json"),
         stored_config: Default::default(),
@@ -17,9 +17,10 @@ pub use service::{
     RuntimeStartupSurface, RuntimeStatusResponse, RuntimeWebSearchSummary,
 };
 pub use state::{
-    cleanup_daemon_state, config_fingerprint, daemon_logs, daemon_paths, load_daemon_metadata,
-    load_last_runtime_failure, DaemonLifecycleAction, DaemonLifecycleResult, DaemonLifecycleState,
-    DaemonLogsView, DaemonPaths, DaemonStatusView,
+    cleanup_daemon_state, config_fingerprint, daemon_logs, daemon_logs_with_filter, daemon_paths,
+    load_daemon_metadata, load_last_runtime_failure, DaemonLifecycleAction, DaemonLifecycleResult,
+    DaemonLifecycleState, DaemonLogFilter, DaemonLogLevel, DaemonLogsView, DaemonPaths,
+    DaemonStatusView,
 };
 pub(crate) use state::{
     clear_persisted_daemon_lifecycle_failures, daemon_log_hint, persist_daemon_lifecycle_failure,
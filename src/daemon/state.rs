@@ -5,6 +5,7 @@ use std::{
 };
 
 use anyhow::{Context, Result};
+use clap::ValueEnum;
 use serde::{Deserialize, Serialize};
 use sha2::{Digest, Sha256};
 
@@ -21,6 +22,84 @@ pub(crate) const DAEMON_LOG_TAIL_READ_BYTE_LIMIT: usize = 128 * 1024;
 pub(crate) const DAEMON_LOG_TAIL_LINE_CHAR_LIMIT: usize = 8 * 1024;
 const DAEMON_LOG_TAIL_READ_CHUNK_SIZE: usize = 8 * 1024;
 
+/// Severity levels as written by the daemon's `tracing` formatter, ordered
+/// from most to least verbose.
+#[derive(Debug, Clone, Copy, Serialize, Deserialize, PartialEq, Eq, PartialOrd, Ord, ValueEnum)]
+#[serde(rename_all = "snake_case")]
+pub enum DaemonLogLevel {
+    Trace,
+    Debug,
+    Info,
+    Warn,
+    Error,
+}
+
+impl DaemonLogLevel {
+    fn parse_token(token: &str) -> Option<Self> {
+        match token {
+            "TRACE" => Some(Self::Trace),
+            "DEBUG" => Some(Self::Debug),
+            "INFO" => Some(Self::Info),
+            "WARN" => Some(Self::Warn),
+            "ERROR" => Some(Self::Error),
+            _ => None,
+        }
+    }
+}
+
+/// Line filter for `holon daemon logs`. An empty filter keeps every line.
+#[derive(Debug, Clone, Default, PartialEq, Eq)]
+pub struct DaemonLogFilter {
+    pub grep: Option<String>,
+    pub min_level: Option<DaemonLogLevel>,
+}
+
+impl DaemonLogFilter {
+    pub fn is_active(&self) -> bool {
+        self.grep.is_some() || self.min_level.is_some()
+    }
+
+    /// Lines without a recognizable level, such as wrapped panic output, are
+    /// dropped once a minimum level is set.
+    pub fn matches(&self, line: &str) -> bool {
+        let plain = strip_ansi_escapes(line);
+        if let Some(grep) = self.grep.as_deref() {
+            if !plain.contains(grep) {
+                return false;
+            }
+        }
+        match self.min_level {
+            Some(min_level) => detect_log_level(&plain).is_some_and(|level| level >= min_level),
+            None => true,
+        }
+    }
+}
+
+fn detect_log_level(line: &str) -> Option<DaemonLogLevel> {
+    line.split_whitespace()
+        .take(3)
+        .find_map(DaemonLogLevel::parse_token)
+}
+
+fn strip_ansi_escapes(line: &str) -> String {
+    let mut plain = String::with_capacity(line.len());
+    let mut chars = line.chars();
+    while let Some(ch) = chars.next() {
+        if ch != '\u{1b}' {
+            plain.push(ch);
+            continue;
+        }
+        if chars.next() == Some('[') {
+            for next in chars.by_ref() {
+                if next.is_ascii_alphabetic() {
+                    break;
+                }
+            }
+        }
+    }
+    plain
+}
+
 #[derive(Debug, Clone, Copy, Serialize, Deserialize, PartialEq, Eq)]
 #[serde(rename_all = "snake_case")]
 pub enum DaemonLifecycleAction {
@@ -315,7 +394,11 @@ pub fn cleanup_daemon_state(config: &AppConfig) -> Result<()> {
     Ok(())
 }
 
-fn read_daemon_log_tail(config: &AppConfig, tail_lines: usize) -> Result<Vec<String>> {
+fn read_daemon_log_tail(
+    config: &AppConfig,
+    tail_lines: usize,
+    filter: &DaemonLogFilter,
+) -> Result<Vec<String>> {
     let log_path = daemon_paths(config).log_path;
     if tail_lines == 0 {
         return Ok(Vec::new());
@@ -337,7 +420,7 @@ fn read_daemon_log_tail(config: &AppConfig, tail_lines: usize) -> Result<Vec<Str
     let mut bytes = Vec::new();
     while start_offset > 0
         && bytes_scanned < DAEMON_LOG_TAIL_READ_BYTE_LIMIT
-        && newline_count <= tail_lines
+        && (filter.is_active() || newline_count <= tail_lines)
     {
         let remaining_budget = DAEMON_LOG_TAIL_READ_BYTE_LIMIT - bytes_scanned;
         let chunk_size = remaining_budget
@@ -362,6 +445,7 @@ fn read_daemon_log_tail(config: &AppConfig, tail_lines: usize) -> Result<Vec<Str
     if start_offset > 0 && !text.starts_with('\n') && !lines.is_empty() {
         lines[0] = truncate_tail_line(&format!("...{}", lines[0]));
     }
+    lines.retain(|line| filter.matches(line));
     for line in &mut lines {
         *line = truncate_tail_line(line);
     }
@@ -387,16 +471,30 @@ fn truncate_tail_line(line: &str) -> String {
 }
 
 pub fn daemon_logs(config: &AppConfig, tail_lines: usize) -> Result<DaemonLogsView> {
+    daemon_logs_with_filter(config, tail_lines, &DaemonLogFilter::default())
+}
+
+/// Filtered lines come from the same bounded window at the end of the log, so
+/// older matches beyond that window are not reported.
+pub fn daemon_logs_with_filter(
+    config: &AppConfig,
+    tail_lines: usize,
+    filter: &DaemonLogFilter,
+) -> Result<DaemonLogsView> {
     let paths = daemon_paths(config);
     let runtime_metadata = load_daemon_metadata(config).ok().flatten();
     let last_failure = latest_known_runtime_failure(config).ok().flatten();
     let startup_failure = load_startup_failure(config).ok().flatten();
     let shutdown_failure = load_shutdown_failure(config).ok().flatten();
-    let tail = read_daemon_log_tail(config, tail_lines)?;
+    let tail = read_daemon_log_tail(config, tail_lines, filter)?;
     let message = if tail_lines == 0 {
         "daemon log tail omitted (--tail 0)".into()
+    } else if tail.is_empty() && filter.is_active() {
+        "no recent daemon log lines match the filter".into()
     } else if tail.is_empty() {
         "no daemon log lines are currently available".into()
+    } else if filter.is_active() {
+        format!("showing the last {} matching daemon log lines", tail.len())
     } else {
         format!("showing the last {} daemon log lines", tail.len())
     };
@@ -454,7 +552,7 @@ pub(crate) fn stale_files(config: &AppConfig) -> Vec<PathBuf> {
 }
 
 pub(crate) fn read_daemon_log_excerpt(config: &AppConfig) -> String {
-    read_daemon_log_tail(config, 20)
+    read_daemon_log_tail(config, 20, &DaemonLogFilter::default())
         .ok()
         .and_then(|lines| {
             lines
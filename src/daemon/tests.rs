@@ -8,10 +8,11 @@ use super::state::{
 };
 use super::{
     clear_persisted_daemon_lifecycle_failures, config_fingerprint, daemon_log_hint, daemon_logs,
-    daemon_paths, daemon_start, daemon_status, daemon_stop, ensure_serve_preflight,
-    load_last_runtime_failure, persist_daemon_lifecycle_failure, prepare_runtime_before_server,
-    runtime_activity_summary, DaemonLifecycleState, RuntimeActivityState, RuntimeConfigSurface,
-    RuntimeControlAuthMode, RuntimeServiceMetadata, RuntimeStartupSurface, RuntimeStatusResponse,
+    daemon_logs_with_filter, daemon_paths, daemon_start, daemon_status, daemon_stop,
+    ensure_serve_preflight, load_last_runtime_failure, persist_daemon_lifecycle_failure,
+    prepare_runtime_before_server, runtime_activity_summary, DaemonLifecycleState, DaemonLogFilter,
+    DaemonLogLevel, RuntimeActivityState, RuntimeConfigSurface, RuntimeControlAuthMode,
+    RuntimeServiceMetadata, RuntimeStartupSurface, RuntimeStatusResponse,
 };
 use crate::config::{provider_registry_for_tests, AppConfig, ProviderId};
 use crate::{
@@ -75,6 +76,7 @@ fn test_config() -> AppConfig {
         max_relevant_episodes: 3,
         control_token: Some("secret".into()),
         control_auth_mode: crate::config::ControlAuthMode::Auto,
+        webhook_secret: None,
         api_cors: Default::default(),
         config_file_path: home.path().join("config.json"),
         stored_config: Default::default(),
@@ -236,6 +238,50 @@ fn daemon_logs_tail_zero_omits_log_tail() {
     assert_eq!(view.message, "daemon log tail omitted (--tail 0)");
 }
 
+#[test]
+fn daemon_logs_filter_by_text_and_minimum_level() {
+    let config = test_config();
+    let paths = daemon_paths(&config);
+    fs::create_dir_all(config.run_dir()).unwrap();
+    fs::write(
+        &paths.log_path,
+        concat!(
+            "2026-01-01T00:00:00Z  INFO holon::host: agent started agent_id=alpha\n",
+            "2026-01-01T00:00:01Z \u{1b}[33m WARN\u{1b}[0m holon::host: slow turn agent_id=alpha\n",
+            "2026-01-01T00:00:02Z ERROR holon::http: request failed agent_id=beta\n",
+            "  continuation without a level agent_id=alpha\n",
+        ),
+    )
+    .unwrap();
+
+    let warn_and_above = DaemonLogFilter {
+        grep: None,
+        min_level: Some(DaemonLogLevel::Warn),
+    };
+    let view = daemon_logs_with_filter(&config, 80, &warn_and_above).unwrap();
+    assert_eq!(view.tail.len(), 2);
+    assert!(view.tail[0].contains("slow turn"));
+    assert!(view.tail[1].contains("request failed"));
+    assert_eq!(view.message, "showing the last 2 matching daemon log lines");
+
+    let alpha = DaemonLogFilter {
+        grep: Some("agent_id=alpha".into()),
+        min_level: None,
+    };
+    let view = daemon_logs_with_filter(&config, 2, &alpha).unwrap();
+    assert_eq!(view.tail.len(), 2);
+    assert!(view.tail[0].contains("slow turn"));
+    assert!(view.tail[1].contains("continuation"));
+
+    let missing = DaemonLogFilter {
+        grep: Some("agent_id=gamma".into()),
+        min_level: Some(DaemonLogLevel::Info),
+    };
+    let view = daemon_logs_with_filter(&config, 80, &missing).unwrap();
+    assert!(view.tail.is_empty());
+    assert_eq!(view.message, "no recent daemon log lines match the filter");
+}
+
 #[test]
 fn daemon_logs_tail_stays_bounded_for_large_log_lines() {
     let config = test_config();
@@ -1,3 +1,4 @@
+use std::fmt::Write as _;
 use std::sync::atomic::{AtomicU64, Ordering};
 use std::sync::OnceLock;
 use std::time::{Duration, Instant};
@@ -48,6 +49,10 @@ static PROVIDER_REQUEST_BUILD: MetricAccumulator = MetricAccumulator::new("provi
 static PROVIDER_ROUND_TOTAL: MetricAccumulator = MetricAccumulator::new("provider.round_total");
 static PROVIDER_RETRY: MetricAccumulator = MetricAccumulator::new("provider.retry");
 
+// Latency distributions for alerting on percentiles rather than averages
+static TURN_LATENCY: LatencyHistogram = LatencyHistogram::new();
+static PROVIDER_ROUND_LATENCY: LatencyHistogram = LatencyHistogram::new();
+
 // Tool phase
 static TOOL_EXECUTION: MetricAccumulator = MetricAccumulator::new("tool.execution");
 
@@ -89,6 +94,22 @@ static PROJECTION_GATE_CANCELLED: AtomicU64 = AtomicU64::new(0);
 static PROJECTION_GATE_ACTIVE_PERMITS: AtomicU64 = AtomicU64::new(0);
 static PROJECTION_GATE_MAX_ACTIVE_PERMITS: AtomicU64 = AtomicU64::new(0);
 
+static HTTP_CLIENT_ERROR_RESPONSES: AtomicU64 = AtomicU64::new(0);
+static HTTP_SERVER_ERROR_RESPONSES: AtomicU64 = AtomicU64::new(0);
+static RUNTIME_LOOP_FAILURES: AtomicU64 = AtomicU64::new(0);
+static TURN_FAILURES: AtomicU64 = AtomicU64::new(0);
+static WEBHOOK_REDELIVERIES: AtomicU64 = AtomicU64::new(0);
+
+/// Per-agent activity exported as labelled gauges next to the process-wide
+/// counters.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct AgentActivityMetric {
+    pub agent_id: String,
+    pub status: String,
+    pub pending_messages: usize,
+    pub active_tasks: usize,
+}
+
 #[derive(Debug, Clone, Serialize, Deserialize, JsonSchema)]
 pub struct PerformanceDiagnosticsSnapshot {
     pub captured_at: String,
@@ -185,12 +206,71 @@ impl MetricAccumulator {
     }
 }
 
+/// Upper bounds, in seconds, of the latency histogram buckets. Turns and
+/// provider rounds run from well under a second to several minutes.
+const LATENCY_BUCKETS_SECONDS: [f64; 10] =
+    [0.25, 0.5, 1.0, 2.5, 5.0, 10.0, 30.0, 60.0, 120.0, 300.0];
+
+struct LatencyHistogram {
+    /// Per-bucket counts; rendering accumulates them into `le` buckets.
+    buckets: [AtomicU64; LATENCY_BUCKETS_SECONDS.len()],
+    count: AtomicU64,
+    total_ms: AtomicU64,
+}
+
+impl LatencyHistogram {
+    const fn new() -> Self {
+        Self {
+            buckets: [const { AtomicU64::new(0) }; LATENCY_BUCKETS_SECONDS.len()],
+            count: AtomicU64::new(0),
+            total_ms: AtomicU64::new(0),
+        }
+    }
+
+    fn record(&self, elapsed: Duration) {
+        let elapsed_ms = elapsed.as_millis().min(u128::from(u64::MAX)) as u64;
+        self.count.fetch_add(1, Ordering::Relaxed);
+        self.total_ms.fetch_add(elapsed_ms, Ordering::Relaxed);
+        let seconds = elapsed.as_secs_f64();
+        if let Some(index) = LATENCY_BUCKETS_SECONDS
+            .iter()
+            .position(|bound| seconds <= *bound)
+        {
+            self.buckets[index].fetch_add(1, Ordering::Relaxed);
+        }
+    }
+}
+
 pub fn record_http_json_response(route: &'static str, elapsed: Duration, bytes: usize) {
     process_started_at();
     HTTP_ALL.record(elapsed, Some(bytes));
     http_route_accumulator(route).record(elapsed, Some(bytes));
 }
 
+pub fn record_http_response_status(status: u16) {
+    match status {
+        400..=499 => {
+            HTTP_CLIENT_ERROR_RESPONSES.fetch_add(1, Ordering::Relaxed);
+        }
+        500..=599 => {
+            HTTP_SERVER_ERROR_RESPONSES.fetch_add(1, Ordering::Relaxed);
+        }
+        _ => {}
+    }
+}
+
+pub fn record_runtime_loop_failure() {
+    RUNTIME_LOOP_FAILURES.fetch_add(1, Ordering::Relaxed);
+}
+
+pub fn record_turn_failure() {
+    TURN_FAILURES.fetch_add(1, Ordering::Relaxed);
+}
+
+pub fn record_webhook_redelivery() {
+    WEBHOOK_REDELIVERIES.fetch_add(1, Ordering::Relaxed);
+}
+
 pub fn record_agent_summary_projection(elapsed: Duration) {
     process_started_at();
     PROJECTION_AGENT_SUMMARY.record(elapsed, None);
@@ -232,6 +312,7 @@ pub fn record_scheduler_poll(outcome: &'static str, elapsed: Duration) {
 pub fn record_turn_total(elapsed: Duration) {
     process_started_at();
     TURN_TOTAL.record(elapsed, None);
+    TURN_LATENCY.record(elapsed);
 }
 
 pub fn record_turn_context_build(elapsed: Duration) {
@@ -264,6 +345,7 @@ pub fn record_provider_request_build(elapsed: Duration) {
 pub fn record_provider_round_total(elapsed: Duration) {
     process_started_at();
     PROVIDER_ROUND_TOTAL.record(elapsed, None);
+    PROVIDER_ROUND_LATENCY.record(elapsed);
 }
 
 pub fn record_provider_retry(elapsed: Duration) {
@@ -471,6 +553,239 @@ pub fn performance_snapshot() -> PerformanceDiagnosticsSnapshot {
     }
 }
 
+/// Render a performance snapshot and per-agent activity in the Prometheus
+/// text exposition format.
+///
+/// Each accumulator group becomes a small set of metric families keyed by a
+/// `name` label, so new accumulators are exported without new families.
+/// Durations use base units (seconds), per Prometheus naming conventions.
+pub fn render_prometheus_metrics(
+    snapshot: &PerformanceDiagnosticsSnapshot,
+    agents: &[AgentActivityMetric],
+) -> String {
+    let mut out = String::new();
+    push_prometheus_header(
+        &mut out,
+        "holon_process_uptime_seconds",
+        "gauge",
+        "Seconds since the runtime process started.",
+    );
+    let _ = writeln!(
+        out,
+        "holon_process_uptime_seconds {}",
+        snapshot.process_uptime_ms as f64 / 1000.0
+    );
+    for (group, metrics) in [
+        ("http", &snapshot.http),
+        ("projection", &snapshot.projections),
+        ("db", &snapshot.db),
+        ("scheduler", &snapshot.scheduler),
+        ("turn", &snapshot.turn),
+        ("provider", &snapshot.provider),
+    ] {
+        push_prometheus_group(&mut out, group, metrics);
+    }
+    for (family, help, histogram) in [
+        (
+            "holon_turn_latency_seconds",
+            "Turn duration in seconds.",
+            &TURN_LATENCY,
+        ),
+        (
+            "holon_provider_round_latency_seconds",
+            "Provider round duration in seconds, including retries.",
+            &PROVIDER_ROUND_LATENCY,
+        ),
+    ] {
+        push_prometheus_histogram(&mut out, family, help, histogram);
+    }
+    let gate = &snapshot.projection_gate;
+    for (field, kind, value) in [
+        ("leaders_total", "counter", gate.leaders),
+        ("joined_waiters_total", "counter", gate.joined_waiters),
+        ("cache_hits_total", "counter", gate.cache_hits),
+        ("cache_misses_total", "counter", gate.cache_misses),
+        ("rejected_total", "counter", gate.rejected),
+        ("failed_total", "counter", gate.failed),
+        ("cancelled_total", "counter", gate.cancelled),
+        ("active_permits", "gauge", gate.active_permits),
+        ("max_active_permits", "gauge", gate.max_active_permits),
+    ] {
+        let family = format!("holon_projection_gate_{field}");
+        push_prometheus_header(&mut out, &family, kind, "Projection gate admission state.");
+        let _ = writeln!(out, "{family} {value}");
+    }
+    push_prometheus_header(
+        &mut out,
+        "holon_http_error_responses_total",
+        "counter",
+        "HTTP responses with a 4xx or 5xx status.",
+    );
+    for (class, counter) in [
+        ("4xx", &HTTP_CLIENT_ERROR_RESPONSES),
+        ("5xx", &HTTP_SERVER_ERROR_RESPONSES),
+    ] {
+        let _ = writeln!(
+            out,
+            "holon_http_error_responses_total{{class=\"{class}\"}} {}",
+            counter.load(Ordering::Relaxed)
+        );
+    }
+    for (family, help, counter) in [
+        (
+            "holon_runtime_loop_failures_total",
+            "Agent runtime loop failures that forced a bounded restart.",
+            &RUNTIME_LOOP_FAILURES,
+        ),
+        (
+            "holon_turn_failures_total",
+            "Turns that ended in a runtime error.",
+            &TURN_FAILURES,
+        ),
+        (
+            "holon_webhook_redeliveries_total",
+            "Generic webhook deliveries skipped because their X-GitHub-Delivery id was already seen.",
+            &WEBHOOK_REDELIVERIES,
+        ),
+    ] {
+        push_prometheus_header(&mut out, family, "counter", help);
+        let _ = writeln!(out, "{family} {}", counter.load(Ordering::Relaxed));
+    }
+    push_prometheus_agent_gauges(&mut out, agents);
+    out
+}
+
+fn push_prometheus_agent_gauges(out: &mut String, agents: &[AgentActivityMetric]) {
+    if agents.is_empty() {
+        return;
+    }
+    push_prometheus_header(
+        out,
+        "holon_agent_pending_messages",
+        "gauge",
+        "Messages queued for the agent and not yet claimed.",
+    );
+    for agent in agents {
+        let _ = writeln!(
+            out,
+            "holon_agent_pending_messages{{agent_id=\"{}\"}} {}",
+            escape_prometheus_label(&agent.agent_id),
+            agent.pending_messages
+        );
+    }
+    push_prometheus_header(
+        out,
+        "holon_agent_active_tasks",
+        "gauge",
+        "Background tasks the agent has running.",
+    );
+    for agent in agents {
+        let _ = writeln!(
+            out,
+            "holon_agent_active_tasks{{agent_id=\"{}\"}} {}",
+            escape_prometheus_label(&agent.agent_id),
+            agent.active_tasks
+        );
+    }
+    push_prometheus_header(
+        out,
+        "holon_agent_status",
+        "gauge",
+        "Current agent status; the series for the active status is 1.",
+    );
+    for agent in agents {
+        let _ = writeln!(
+            out,
+            "holon_agent_status{{agent_id=\"{}\",status=\"{}\"}} 1",
+            escape_prometheus_label(&agent.agent_id),
+            escape_prometheus_label(&agent.status)
+        );
+    }
+}
+
+type PrometheusSample = fn(&MetricSnapshot) -> Option<f64>;
+
+fn push_prometheus_group(out: &mut String, group: &str, metrics: &[MetricSnapshot]) {
+    let families: [(&str, &str, &str, PrometheusSample); 4] = [
+        (
+            "operations_total",
+            "counter",
+            "Recorded operations.",
+            |metric| Some(metric.count as f64),
+        ),
+        (
+            "duration_seconds_total",
+            "counter",
+            "Total recorded duration in seconds.",
+            |metric| Some(metric.total_ms as f64 / 1000.0),
+        ),
+        (
+            "duration_seconds_max",
+            "gauge",
+            "Longest duration recorded since the process started, in seconds.",
+            |metric| Some(metric.max_ms as f64 / 1000.0),
+        ),
+        (
+            "response_bytes_total",
+            "counter",
+            "Total recorded response bytes.",
+            |metric| metric.total_bytes.map(|bytes| bytes as f64),
+        ),
+    ];
+    for (suffix, kind, help, sample) in families {
+        let samples = metrics
+            .iter()
+            .filter_map(|metric| sample(metric).map(|value| (metric.name.as_str(), value)))
+            .collect::<Vec<_>>();
+        if samples.is_empty() {
+            continue;
+        }
+        let family = format!("holon_{group}_{suffix}");
+        push_prometheus_header(out, &family, kind, help);
+        for (name, value) in samples {
+            let _ = writeln!(
+                out,
+                "{family}{{name=\"{}\"}} {value}",
+                escape_prometheus_label(name)
+            );
+        }
+    }
+}
+
+fn push_prometheus_histogram(
+    out: &mut String,
+    family: &str,
+    help: &str,
+    histogram: &LatencyHistogram,
+) {
+    push_prometheus_header(out, family, "histogram", help);
+    let count = histogram.count.load(Ordering::Relaxed);
+    let mut cumulative = 0;
+    for (bound, bucket) in LATENCY_BUCKETS_SECONDS.iter().zip(&histogram.buckets) {
+        cumulative += bucket.load(Ordering::Relaxed);
+        let _ = writeln!(out, "{family}_bucket{{le=\"{bound}\"}} {cumulative}");
+    }
+    let _ = writeln!(out, "{family}_bucket{{le=\"+Inf\"}} {count}");
+    let _ = writeln!(
+        out,
+        "{family}_sum {}",
+        histogram.total_ms.load(Ordering::Relaxed) as f64 / 1000.0
+    );
+    let _ = writeln!(out, "{family}_count {count}");
+}
+
+fn push_prometheus_header(out: &mut String, family: &str, kind: &str, help: &str) {
+    let _ = writeln!(out, "# HELP {family} {help}");
+    let _ = writeln!(out, "# TYPE {family} {kind}");
+}
+
+fn escape_prometheus_label(value: &str) -> String {
+    value
+        .replace('\\', "\\\\")
+        .replace('"', "\\\"")
+        .replace('\n', "\\n")
+}
+
 fn update_max(target: &AtomicU64, value: u64) {
     let mut current = target.load(Ordering::Relaxed);
     while value > current {
@@ -655,4 +970,74 @@ mod tests {
             );
         }
     }
+
+    #[test]
+    fn prometheus_rendering_labels_accumulators_by_name() {
+        record_http_json_response("/agents/list", Duration::from_millis(4), 256);
+        record_turn_total(Duration::from_millis(12));
+
+        let rendered = render_prometheus_metrics(&performance_snapshot(), &[]);
+
+        assert!(rendered.contains("# TYPE holon_process_uptime_seconds gauge\n"));
+        assert!(rendered.contains("# TYPE holon_http_operations_total counter\n"));
+        assert!(rendered.contains("holon_http_operations_total{name=\"http.json./agents/list\"} "));
+        assert!(rendered.contains("holon_http_response_bytes_total{name=\"http.json.all\"} "));
+        assert!(rendered.contains("holon_turn_duration_seconds_max{name=\"turn.total\"} "));
+        assert!(rendered.contains("# TYPE holon_turn_latency_seconds histogram\n"));
+        assert!(rendered.contains("holon_turn_latency_seconds_bucket{le=\"+Inf\"} "));
+        assert!(rendered.contains("holon_provider_round_latency_seconds_count "));
+        assert!(!rendered.contains("milliseconds"));
+        assert!(!rendered.contains("holon_turn_response_bytes_total"));
+        assert!(rendered.contains("# TYPE holon_projection_gate_active_permits gauge\n"));
+        assert_eq!(escape_prometheus_label("a\"b\\c"), "a\\\"b\\\\c");
+    }
+
+    #[test]
+    fn latency_histogram_renders_cumulative_buckets() {
+        let histogram = LatencyHistogram::new();
+        histogram.record(Duration::from_millis(200));
+        histogram.record(Duration::from_secs(3));
+        histogram.record(Duration::from_secs(600));
+
+        let mut rendered = String::new();
+        push_prometheus_histogram(&mut rendered, "holon_test_seconds", "Test.", &histogram);
+
+        assert!(rendered.contains("holon_test_seconds_bucket{le=\"0.25\"} 1\n"));
+        assert!(rendered.contains("holon_test_seconds_bucket{le=\"5\"} 2\n"));
+        assert!(rendered.contains("holon_test_seconds_bucket{le=\"300\"} 2\n"));
+        assert!(rendered.contains("holon_test_seconds_bucket{le=\"+Inf\"} 3\n"));
+        assert!(rendered.contains("holon_test_seconds_sum 603.2\n"));
+        assert!(rendered.contains("holon_test_seconds_count 3\n"));
+    }
+
+    #[test]
+    fn prometheus_rendering_exports_agent_gauges_and_error_counters() {
+        record_http_response_status(404);
+        record_http_response_status(503);
+        record_http_response_status(200);
+        record_runtime_loop_failure();
+        record_turn_failure();
+        record_webhook_redelivery();
+
+        let rendered = render_prometheus_metrics(
+            &performance_snapshot(),
+            &[AgentActivityMetric {
+                agent_id: "default".into(),
+                status: "awake_running".into(),
+                pending_messages: 3,
+                active_tasks: 1,
+            }],
+        );
+
+        assert!(rendered.contains("# TYPE holon_agent_pending_messages gauge\n"));
+        assert!(rendered.contains("holon_agent_pending_messages{agent_id=\"default\"} 3\n"));
+        assert!(rendered.contains("holon_agent_active_tasks{agent_id=\"default\"} 1\n"));
+        assert!(rendered
+            .contains("holon_agent_status{agent_id=\"default\",status=\"awake_running\"} 1\n"));
+        assert!(rendered.contains("holon_http_error_responses_total{class=\"4xx\"} "));
+        assert!(rendered.contains("holon_http_error_responses_total{class=\"5xx\"} "));
+        assert!(rendered.contains("# TYPE holon_runtime_loop_failures_total counter\n"));
+        assert!(rendered.contains("# TYPE holon_turn_failures_total counter\n"));
+        assert!(rendered.contains("# TYPE holon_webhook_redeliveries_total counter\n"));
+    }
 }
@@ -59,6 +59,7 @@ use crate::{
 pub struct PublicAgentActivitySnapshot {
     pub agent_id: String,
     pub status: AgentStatus,
+    pub pending: usize,
     pub active_task_count: usize,
     pub last_runtime_failure: Option<RuntimeFailureSummary>,
 }
@@ -1256,6 +1257,7 @@ impl RuntimeHost {
                 snapshots.push(PublicAgentActivitySnapshot {
                     agent_id: identity.agent_id,
                     status: state.status.clone(),
+                    pending: state.pending,
                     active_task_count,
                     last_runtime_failure: state.last_runtime_failure,
                 });
@@ -1269,6 +1271,7 @@ impl RuntimeHost {
             snapshots.push(PublicAgentActivitySnapshot {
                 agent_id: identity.agent_id,
                 status: state.status.clone(),
+                pending: state.pending,
                 active_task_count,
                 last_runtime_failure: state.last_runtime_failure,
             });
@@ -2735,6 +2738,7 @@ mod tests {
             max_relevant_episodes: 3,
             control_token: Some("secret".into()),
             control_auth_mode: ControlAuthMode::Auto,
+            webhook_secret: None,
             api_cors: Default::default(),
             config_file_path: home_path.join("config.json"),
             stored_config: Default::default(),
@@ -3,6 +3,7 @@ use anyhow::Context as _;
 
 const MAX_CONTROL_PROMPT_IMAGE_ATTACHMENT_BYTES: u64 = 20 * 1024 * 1024;
 const MAX_CONTROL_PROMPT_FILE_ATTACHMENT_BYTES: u64 = 20 * 1024 * 1024;
+const PROMETHEUS_TEXT_CONTENT_TYPE: &str = "text/plain; version=0.0.4; charset=utf-8";
 
 pub async fn runtime_status(
     State(state): State<Arc<AppState>>,
@@ -56,6 +57,85 @@ pub async fn runtime_performance(
     Ok(Json(diagnostics::performance_snapshot()))
 }
 
+pub async fn runtime_metrics(
+    State(state): State<Arc<AppState>>,
+    headers: HeaderMap,
+) -> Result<impl IntoResponse, (StatusCode, Json<Value>)> {
+    authorize_control(&headers, &state).map_err(|err| auth_required(err.to_string()))?;
+    let agents = state
+        .host
+        .public_agent_activity_snapshots()
+        .await
+        .map_err(error_response)?
+        .into_iter()
+        .map(|agent| diagnostics::AgentActivityMetric {
+            status: serde_json::to_value(&agent.status)
+                .ok()
+                .and_then(|value| value.as_str().map(str::to_string))
+                .unwrap_or_default(),
+            agent_id: agent.agent_id,
+            pending_messages: agent.pending,
+            active_tasks: agent.active_task_count,
+        })
+        .collect::<Vec<_>>();
+    Ok((
+        [(CONTENT_TYPE, PROMETHEUS_TEXT_CONTENT_TYPE)],
+        diagnostics::render_prometheus_metrics(&diagnostics::performance_snapshot(), &agents),
+    ))
+}
+
+#[derive(Debug, Clone, Serialize, Deserialize, JsonSchema, PartialEq, Eq)]
+#[serde(deny_unknown_fields)]
+pub struct RuntimeLogFilterUpdateRequest {
+    /// Filter directives in `RUST_LOG` syntax, e.g. `debug` or `holon=debug,info`.
+    pub filter: String,
+}
+
+#[derive(Debug, Clone, Serialize, Deserialize, JsonSchema, PartialEq, Eq)]
+pub struct RuntimeLogFilterResponse {
+    pub ok: bool,
+    pub filter: String,
+    #[serde(default, skip_serializing_if = "Option::is_none")]
+    pub previous_filter: Option<String>,
+}
+
+pub async fn runtime_log_filter(
+    State(state): State<Arc<AppState>>,
+    headers: HeaderMap,
+) -> Result<impl IntoResponse, (StatusCode, Json<Value>)> {
+    authorize_control(&headers, &state).map_err(|err| auth_required(err.to_string()))?;
+    let filter = log_filter::current_log_filter()
+        .ok_or_else(|| service_unavailable("log filter is not reloadable in this process"))?;
+    Ok(Json(RuntimeLogFilterResponse {
+        ok: true,
+        filter,
+        previous_filter: None,
+    }))
+}
+
+pub async fn runtime_log_filter_update(
+    State(state): State<Arc<AppState>>,
+    headers: HeaderMap,
+    Json(request): Json<RuntimeLogFilterUpdateRequest>,
+) -> Result<impl IntoResponse, (StatusCode, Json<Value>)> {
+    authorize_control(&headers, &state).map_err(|err| auth_required(err.to_string()))?;
+    if log_filter::current_log_filter().is_none() {
+        return Err(service_unavailable(
+            "log filter is not reloadable in this process",
+        ));
+    }
+    let previous_filter =
+        log_filter::set_log_filter(&request.filter).map_err(|err| bad_request(err.to_string()))?;
+    let filter =
+        log_filter::current_log_filter().unwrap_or_else(|| request.filter.trim().to_string());
+    tracing::info!(%filter, %previous_filter, "runtime log filter updated");
+    Ok(Json(RuntimeLogFilterResponse {
+        ok: true,
+        filter,
+        previous_filter: Some(previous_filter),
+    }))
+}
+
 #[derive(Debug, Clone, Serialize, Deserialize, JsonSchema, PartialEq, Eq)]
 pub struct RuntimeConfigReadResponse {
     pub ok: bool,
@@ -515,6 +595,7 @@ pub async fn abort_current_run(
         .abort_current_run(CurrentRunAbortRequest {
             run_id: request.run_id.clone(),
             mode,
+            reason: "operator_aborted".into(),
         })
         .await
         .map_err(abort_error_response)?;
@@ -176,10 +176,51 @@ pub async fn generic_webhook(
     Path(agent_id): Path<String>,
     State(state): State<Arc<AppState>>,
     headers: HeaderMap,
-    Json(payload): Json<Value>,
+    body: Bytes,
 ) -> Result<impl IntoResponse, (StatusCode, Json<Value>)> {
-    authorize_remote_access(&headers, &state).map_err(|err| auth_required(err.to_string()))?;
-    enqueue_internal(
+    // A configured webhook secret replaces bearer auth: GitHub-style senders
+    // can sign payloads but cannot attach an Authorization header.
+    match state.host.config().webhook_secret.as_deref() {
+        Some(secret) => verify_webhook_signature(&headers, secret, &body)
+            .map_err(|err| webhook_signature_invalid(err.to_string()))?,
+        None => authorize_remote_access(&headers, &state)
+            .map_err(|err| auth_required(err.to_string()))?,
+    }
+    let payload: Value = serde_json::from_slice(&body)
+        .map_err(|err| bad_request(format!("invalid JSON webhook body: {err}")))?;
+    // GitHub retries and manual redeliveries reuse the delivery id; answer them
+    // with the message the first delivery produced instead of a second turn.
+    let delivery_id = headers
+        .get(WEBHOOK_DELIVERY_HEADER)
+        .and_then(|value| value.to_str().ok())
+        .map(str::trim)
+        .filter(|value| !value.is_empty())
+        .map(ToString::to_string);
+    let reservation = match delivery_id.as_deref() {
+        Some(delivery_id) => match state.webhook_deliveries.reserve(&agent_id, delivery_id) {
+            WebhookDeliveryReservation::Reserved(reserved) => Some(reserved),
+            WebhookDeliveryReservation::Existing(message_id) => {
+                diagnostics::record_webhook_redelivery();
+                info!(
+                    agent_id = %agent_id,
+                    delivery_id = %delivery_id,
+                    message_id = %message_id,
+                    "generic webhook redelivery ignored"
+                );
+                return Ok(Json(EnqueueResponse {
+                    ok: true,
+                    agent_id,
+                    message_id,
+                }));
+            }
+            WebhookDeliveryReservation::InFlight => {
+                diagnostics::record_webhook_redelivery();
+                return Err(webhook_delivery_in_flight(delivery_id));
+            }
+        },
+        None => None,
+    };
+    let response = enqueue_internal(
         state,
         agent_id,
         EnqueueRequest {
@@ -202,5 +243,143 @@ pub async fn generic_webhook(
             admission_context: public_admission_context(),
         },
     )
-    .await
+    .await?;
+    if let Some(reservation) = reservation {
+        reservation.record(&response.message_id);
+    }
+    Ok(response)
+}
+
+const WEBHOOK_DELIVERY_HEADER: &str = "x-github-delivery";
+const WEBHOOK_SIGNATURE_HEADER: &str = "x-hub-signature-256";
+const WEBHOOK_SIGNATURE_PREFIX: &str = "sha256=";
+
+fn verify_webhook_signature(headers: &HeaderMap, secret: &str, body: &[u8]) -> Result<()> {
+    let provided = headers
+        .get(WEBHOOK_SIGNATURE_HEADER)
+        .and_then(|value| value.to_str().ok())
+        .ok_or_else(|| anyhow!("missing X-Hub-Signature-256 header"))?;
+    let provided = provided
+        .trim()
+        .strip_prefix(WEBHOOK_SIGNATURE_PREFIX)
+        .ok_or_else(|| anyhow!("X-Hub-Signature-256 must use the sha256= scheme"))?
+        .to_ascii_lowercase();
+    let expected = webhook_signature_hex(secret.as_bytes(), body);
+    if !constant_time_eq(provided.as_bytes(), expected.as_bytes()) {
+        return Err(anyhow!("invalid webhook signature"));
+    }
+    Ok(())
+}
+
+fn webhook_signature_hex(secret: &[u8], body: &[u8]) -> String {
+    hmac_sha256(secret, body)
+        .iter()
+        .map(|byte| format!("{byte:02x}"))
+        .collect()
+}
+
+fn hmac_sha256(key: &[u8], message: &[u8]) -> Vec<u8> {
+    use sha2::{Digest as _, Sha256};
+
+    const BLOCK_SIZE: usize = 64;
+    let mut block = [0u8; BLOCK_SIZE];
+    if key.len() > BLOCK_SIZE {
+        let digest = Sha256::digest(key);
+        block[..digest.len()].copy_from_slice(&digest);
+    } else {
+        block[..key.len()].copy_from_slice(key);
+    }
+    let mut inner = Sha256::new();
+    inner.update(block.map(|byte| byte ^ 0x36));
+    inner.update(message);
+    let inner_digest = inner.finalize();
+    let mut outer = Sha256::new();
+    outer.update(block.map(|byte| byte ^ 0x5c));
+    outer.update(inner_digest);
+    outer.finalize().to_vec()
+}
+
+fn constant_time_eq(left: &[u8], right: &[u8]) -> bool {
+    left.len() == right.len()
+        && left
+            .iter()
+            .zip(right)
+            .fold(0u8, |diff, (lhs, rhs)| diff | (lhs ^ rhs))
+            == 0
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    // Test vector from GitHub's "Validating webhook deliveries" guide.
+    const GITHUB_SECRET: &str = "It's a Secret to Everybody";
+    const GITHUB_PAYLOAD: &[u8] = b"Hello, World!";
+    const GITHUB_SIGNATURE: &str =
+        "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17";
+
+    fn signed_headers(signature: &str) -> HeaderMap {
+        let mut headers = HeaderMap::new();
+        headers.insert(
+            WEBHOOK_SIGNATURE_HEADER,
+            HeaderValue::from_str(signature).unwrap(),
+        );
+        headers
+    }
+
+    #[test]
+    fn webhook_signature_matches_github_test_vector() {
+        assert_eq!(
+            format!(
+                "{WEBHOOK_SIGNATURE_PREFIX}{}",
+                webhook_signature_hex(GITHUB_SECRET.as_bytes(), GITHUB_PAYLOAD)
+            ),
+            GITHUB_SIGNATURE
+        );
+        verify_webhook_signature(
+            &signed_headers(GITHUB_SIGNATURE),
+            GITHUB_SECRET,
+            GITHUB_PAYLOAD,
+        )
+        .unwrap();
+    }
+
+    #[test]
+    fn webhook_signature_rejects_missing_tampered_and_unprefixed_signatures() {
+        let missing =
+            verify_webhook_signature(&HeaderMap::new(), GITHUB_SECRET, GITHUB_PAYLOAD).unwrap_err();
+        assert!(missing.to_string().contains("missing X-Hub-Signature-256"));
+
+        let tampered = verify_webhook_signature(
+            &signed_headers(GITHUB_SIGNATURE),
+            GITHUB_SECRET,
+            b"Hello, World?",
+        )
+        .unwrap_err();
+        assert_eq!(tampered.to_string(), "invalid webhook signature");
+
+        let unprefixed = verify_webhook_signature(
+            &signed_headers(GITHUB_SIGNATURE.trim_start_matches(WEBHOOK_SIGNATURE_PREFIX)),
+            GITHUB_SECRET,
+            GITHUB_PAYLOAD,
+        )
+        .unwrap_err();
+        assert!(unprefixed.to_string().contains("sha256= scheme"));
+    }
+
+    #[test]
+    fn hmac_sha256_hashes_keys_longer_than_one_block() {
+        let long_key = [0xaa_u8; 131];
+        // RFC 4231 test case 6.
+        assert_eq!(
+            hmac_sha256(
+                &long_key,
+                b"Test Using Larger Than Block-Size Key - Hash Key First"
+            )
+            .iter()
+            .map(|byte| format!("{byte:02x}"))
+            .collect::<String>(),
+            "60e431591ee0b67f0d8a26aacbf5b77f8e0bc6213728c5140546040f0ee37f54"
+        );
+    }
 }
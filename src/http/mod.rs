@@ -69,6 +69,7 @@ pub(crate) use crate::{
     diagnostics,
     host::{PublicAgentError, RuntimeHost},
     ingress::{InboundRequest, WakeDisposition, WakeHint},
+    log_filter,
     operator_event::{
         is_operator_event_in_display_mode, OperatorDisplayMode, OperatorPresentationContext,
     },
@@ -104,6 +105,7 @@ mod tasks;
 mod templates;
 mod types;
 mod web;
+mod webhook_deliveries;
 mod workspace_files;
 
 // Re-export shared helpers used across submodules.
@@ -115,6 +117,7 @@ pub(crate) use state::{
     public_admission_context, EnqueueIngress,
 };
 pub(crate) use web::{accepts_html, web_asset_response};
+pub(crate) use webhook_deliveries::{WebhookDeliveryLog, WebhookDeliveryReservation};
 
 pub use agents::*;
 pub use control::*;
@@ -186,6 +189,7 @@ pub struct AppState {
     pub skill_library_write_jobs: Arc<tokio::sync::Semaphore>,
     pub template_remote_source_sync_jobs: Arc<tokio::sync::Semaphore>,
     pub(crate) projection_gate: Arc<ProjectionGate>,
+    pub(crate) webhook_deliveries: Arc<WebhookDeliveryLog>,
 }
 
 #[derive(Debug, Clone, Serialize)]
@@ -286,6 +290,7 @@ impl AppState {
         let skill_library_write_jobs = Arc::new(tokio::sync::Semaphore::new(1));
         let template_remote_source_sync_jobs = Arc::new(tokio::sync::Semaphore::new(1));
         let projection_gate = Arc::new(ProjectionGate::default());
+        let webhook_deliveries = Arc::new(WebhookDeliveryLog::default());
         Self {
             host,
             require_control_token,
@@ -297,6 +302,7 @@ impl AppState {
             skill_library_write_jobs,
             template_remote_source_sync_jobs,
             projection_gate,
+            webhook_deliveries,
         }
     }
 
@@ -314,6 +320,7 @@ impl AppState {
         let skill_library_write_jobs = Arc::new(tokio::sync::Semaphore::new(1));
         let template_remote_source_sync_jobs = Arc::new(tokio::sync::Semaphore::new(1));
         let projection_gate = Arc::new(ProjectionGate::default());
+        let webhook_deliveries = Arc::new(WebhookDeliveryLog::default());
         Self {
             host,
             require_control_token: false,
@@ -325,6 +332,7 @@ impl AppState {
             skill_library_write_jobs,
             template_remote_source_sync_jobs,
             projection_gate,
+            webhook_deliveries,
         }
     }
 
@@ -499,6 +507,15 @@ pub fn router(state: AppState) -> Router {
             "/control/runtime/performance",
             get(control::runtime_performance),
         )
+        .route("/control/runtime/metrics", get(control::runtime_metrics))
+        .route(
+            "/control/runtime/log-filter",
+            get(control::runtime_log_filter),
+        )
+        .route(
+            "/control/runtime/log-filter",
+            patch(control::runtime_log_filter_update),
+        )
         .route("/control/runtime/config", get(control::runtime_config))
         .route(
             "/control/runtime/config",
@@ -662,6 +679,7 @@ pub fn router(state: AppState) -> Router {
                             .and_then(|value| value.to_str().ok())
                             .and_then(|value| value.parse::<usize>().ok());
                         span.record("status", response.status().as_u16());
+                        diagnostics::record_http_response_status(response.status().as_u16());
                         span.record("elapsed_ms", elapsed.as_millis() as u64);
                         span.record("in_flight", in_flight);
                         if let Some(bytes) = response_bytes {
@@ -946,6 +964,27 @@ pub(crate) fn auth_required(reason: impl Into<String>) -> (StatusCode, Json<Valu
     )
 }
 
+pub(crate) fn webhook_signature_invalid(reason: impl Into<String>) -> (StatusCode, Json<Value>) {
+    http_error(
+        StatusCode::UNAUTHORIZED,
+        HttpErrorEnvelope::new(reason)
+            .code("webhook_signature_invalid")
+            .hint("sign the raw request body with HMAC-SHA256 and send it as X-Hub-Signature-256"),
+    )
+}
+
+pub(crate) fn webhook_delivery_in_flight(delivery_id: &str) -> (StatusCode, Json<Value>) {
+    http_error(
+        StatusCode::CONFLICT,
+        HttpErrorEnvelope::new(format!(
+            "webhook delivery {delivery_id} is already being processed"
+        ))
+        .code("webhook_delivery_in_flight")
+        .retryable(true)
+        .extension("delivery_id", delivery_id),
+    )
+}
+
 pub(crate) fn bad_request(reason: impl Into<String>) -> (StatusCode, Json<Value>) {
     http_error(StatusCode::BAD_REQUEST, HttpErrorEnvelope::new(reason))
 }
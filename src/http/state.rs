@@ -57,7 +57,7 @@ pub(crate) async fn enqueue_internal(
     agent_id: String,
     request: EnqueueRequest,
     ingress: EnqueueIngress,
-) -> Result<impl IntoResponse, (StatusCode, Json<Value>)> {
+) -> Result<Json<EnqueueResponse>, (StatusCode, Json<Value>)> {
     let kind = request.kind.unwrap_or(MessageKind::WebhookEvent);
     if matches!(kind, MessageKind::SystemTick | MessageKind::CallbackEvent) {
         return Err(forbidden(
@@ -0,0 +1,221 @@
+use std::{
+    collections::{HashMap, VecDeque},
+    sync::{Arc, Mutex},
+};
+
+use tokio::time::{Duration, Instant};
+
+const DEFAULT_CAPACITY: usize = 4096;
+const DEFAULT_WINDOW: Duration = Duration::from_secs(60 * 60);
+
+#[derive(Debug, Clone, PartialEq, Eq, Hash)]
+struct DeliveryKey {
+    agent_id: String,
+    delivery_id: String,
+}
+
+#[derive(Debug, Clone)]
+enum DeliveryState {
+    InFlight,
+    Recorded(String),
+}
+
+#[derive(Debug, Default)]
+struct Entries {
+    deliveries: HashMap<DeliveryKey, DeliveryState>,
+    recorded: VecDeque<(Instant, DeliveryKey)>,
+}
+
+/// Remembers recent webhook delivery ids so a sender's redelivery of the same
+/// event resolves to the message it already produced instead of a new turn.
+/// Recorded entries expire after a fixed window and the oldest are evicted at
+/// capacity.
+#[derive(Debug)]
+pub(crate) struct WebhookDeliveryLog {
+    entries: Mutex<Entries>,
+    capacity: usize,
+    window: Duration,
+}
+
+#[derive(Debug)]
+pub(crate) enum WebhookDeliveryReservation {
+    /// First sighting of the id; the caller enqueues and then records it.
+    Reserved(ReservedWebhookDelivery),
+    /// Already delivered within the window, as this message.
+    Existing(String),
+    /// Another request with the same id is still being enqueued.
+    InFlight,
+}
+
+/// Holds a delivery id while its first request enqueues. Dropping it without
+/// recording releases the id, so a failed or cancelled enqueue can be retried.
+#[derive(Debug)]
+pub(crate) struct ReservedWebhookDelivery {
+    log: Arc<WebhookDeliveryLog>,
+    key: Option<DeliveryKey>,
+}
+
+impl Default for WebhookDeliveryLog {
+    fn default() -> Self {
+        Self::new(DEFAULT_CAPACITY, DEFAULT_WINDOW)
+    }
+}
+
+impl WebhookDeliveryLog {
+    pub(super) fn new(capacity: usize, window: Duration) -> Self {
+        Self {
+            entries: Mutex::new(Entries::default()),
+            capacity,
+            window,
+        }
+    }
+
+    pub(crate) fn reserve(
+        self: &Arc<Self>,
+        agent_id: &str,
+        delivery_id: &str,
+    ) -> WebhookDeliveryReservation {
+        let key = DeliveryKey {
+            agent_id: agent_id.to_string(),
+            delivery_id: delivery_id.to_string(),
+        };
+        let mut entries = self.entries.lock().expect("webhook delivery log poisoned");
+        self.prune(&mut entries, Instant::now());
+        match entries.deliveries.get(&key) {
+            Some(DeliveryState::Recorded(message_id)) => {
+                WebhookDeliveryReservation::Existing(message_id.clone())
+            }
+            Some(DeliveryState::InFlight) => WebhookDeliveryReservation::InFlight,
+            None => {
+                entries
+                    .deliveries
+                    .insert(key.clone(), DeliveryState::InFlight);
+                WebhookDeliveryReservation::Reserved(ReservedWebhookDelivery {
+                    log: Arc::clone(self),
+                    key: Some(key),
+                })
+            }
+        }
+    }
+
+    fn record(&self, key: DeliveryKey, message_id: &str) {
+        let now = Instant::now();
+        let mut entries = self.entries.lock().expect("webhook delivery log poisoned");
+        self.prune(&mut entries, now);
+        entries
+            .deliveries
+            .insert(key.clone(), DeliveryState::Recorded(message_id.to_string()));
+        entries.recorded.push_back((now, key));
+        while entries.recorded.len() > self.capacity {
+            if let Some((_, evicted)) = entries.recorded.pop_front() {
+                entries.deliveries.remove(&evicted);
+            }
+        }
+    }
+
+    fn release(&self, key: &DeliveryKey) {
+        let mut entries = self.entries.lock().expect("webhook delivery log poisoned");
+        if matches!(entries.deliveries.get(key), Some(DeliveryState::InFlight)) {
+            entries.deliveries.remove(key);
+        }
+    }
+
+    fn prune(&self, entries: &mut Entries, now: Instant) {
+        while let Some((recorded_at, _)) = entries.recorded.front() {
+            if now.duration_since(*recorded_at) < self.window {
+                break;
+            }
+            if let Some((_, expired)) = entries.recorded.pop_front() {
+                entries.deliveries.remove(&expired);
+            }
+        }
+    }
+}
+
+impl ReservedWebhookDelivery {
+    pub(crate) fn record(mut self, message_id: &str) {
+        if let Some(key) = self.key.take() {
+            self.log.record(key, message_id);
+        }
+    }
+}
+
+impl Drop for ReservedWebhookDelivery {
+    fn drop(&mut self) {
+        if let Some(key) = self.key.take() {
+            self.log.release(&key);
+        }
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    fn reserved(reservation: WebhookDeliveryReservation) -> ReservedWebhookDelivery {
+        match reservation {
+            WebhookDeliveryReservation::Reserved(reserved) => reserved,
+            other => panic!("expected a fresh reservation, got {other:?}"),
+        }
+    }
+
+    fn existing(reservation: WebhookDeliveryReservation) -> Option<String> {
+        match reservation {
+            WebhookDeliveryReservation::Existing(message_id) => Some(message_id),
+            _ => None,
+        }
+    }
+
+    #[test]
+    fn redelivery_resolves_to_the_first_message_per_agent() {
+        let log = Arc::new(WebhookDeliveryLog::new(8, Duration::from_secs(60)));
+        reserved(log.reserve("default", "delivery-1")).record("msg_1");
+
+        assert_eq!(
+            existing(log.reserve("default", "delivery-1")).as_deref(),
+            Some("msg_1")
+        );
+        reserved(log.reserve("other", "delivery-1"));
+        reserved(log.reserve("default", "delivery-2"));
+    }
+
+    #[test]
+    fn concurrent_delivery_is_in_flight_until_released() {
+        let log = Arc::new(WebhookDeliveryLog::new(8, Duration::from_secs(60)));
+        let first = reserved(log.reserve("default", "delivery-1"));
+
+        assert!(matches!(
+            log.reserve("default", "delivery-1"),
+            WebhookDeliveryReservation::InFlight
+        ));
+
+        drop(first);
+        reserved(log.reserve("default", "delivery-1"));
+    }
+
+    #[test]
+    fn oldest_deliveries_are_evicted_at_capacity() {
+        let log = Arc::new(WebhookDeliveryLog::new(2, Duration::from_secs(60)));
+        reserved(log.reserve("default", "delivery-1")).record("msg_1");
+        reserved(log.reserve("default", "delivery-2")).record("msg_2");
+        reserved(log.reserve("default", "delivery-3")).record("msg_3");
+
+        assert_eq!(existing(log.reserve("default", "delivery-1")), None);
+        assert_eq!(
+            existing(log.reserve("default", "delivery-3")).as_deref(),
+            Some("msg_3")
+        );
+    }
+
+    #[tokio::test(start_paused = true)]
+    async fn deliveries_expire_after_the_window() {
+        let log = Arc::new(WebhookDeliveryLog::new(8, Duration::from_secs(60)));
+        reserved(log.reserve("default", "delivery-1")).record("msg_1");
+
+        tokio::time::advance(Duration::from_secs(59)).await;
+        assert!(existing(log.reserve("default", "delivery-1")).is_some());
+
+        tokio::time::advance(Duration::from_secs(1)).await;
+        assert_eq!(existing(log.reserve("default", "delivery-1")), None);
+    }
+}
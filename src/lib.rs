@@ -20,6 +20,7 @@ mod host_registry;
 pub mod http;
 pub mod http_dto;
 pub mod ingress;
+pub mod log_filter;
 pub mod memory;
 pub mod model_catalog;
 pub mod model_config_migration;
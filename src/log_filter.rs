@@ -0,0 +1,72 @@
+//! Process-wide tracing filter that operators can change without restarting.
+//!
+//! The binary installs a reloadable [`EnvFilter`] at startup. Library code
+//! reads and replaces it through this module so the control plane can raise
+//! verbosity during an incident and lower it again afterwards.
+
+use std::sync::OnceLock;
+
+use anyhow::{anyhow, Result};
+use tracing_subscriber::{reload, EnvFilter, Registry};
+
+pub type LogFilterHandle = reload::Handle<EnvFilter, Registry>;
+
+static LOG_FILTER_HANDLE: OnceLock<LogFilterHandle> = OnceLock::new();
+
+/// Registers the reload handle for the process subscriber. Only the first
+/// registration takes effect.
+pub fn install_log_filter_handle(handle: LogFilterHandle) {
+    let _ = LOG_FILTER_HANDLE.set(handle);
+}
+
+/// Wraps `filter` in a reloadable layer and registers its handle, so the
+/// subscriber built from the returned layer can be retuned at runtime.
+pub fn reloadable_log_filter(filter: EnvFilter) -> reload::Layer<EnvFilter, Registry> {
+    let (layer, handle) = reload::Layer::new(filter);
+    install_log_filter_handle(handle);
+    layer
+}
+
+/// Returns the active filter directives, or `None` when this process did not
+/// install a reloadable subscriber.
+pub fn current_log_filter() -> Option<String> {
+    LOG_FILTER_HANDLE
+        .get()?
+        .with_current(ToString::to_string)
+        .ok()
+}
+
+/// Replaces the active filter with `directives`, using the same syntax as
+/// `RUST_LOG`. Returns the previous directives.
+pub fn set_log_filter(directives: &str) -> Result<String> {
+    let directives = directives.trim();
+    if directives.is_empty() {
+        return Err(anyhow!("log filter must not be empty"));
+    }
+    let filter = EnvFilter::try_new(directives)
+        .map_err(|err| anyhow!("invalid log filter {directives:?}: {err}"))?;
+    let handle = LOG_FILTER_HANDLE
+        .get()
+        .ok_or_else(|| anyhow!("log filter is not reloadable in this process"))?;
+    let previous = handle
+        .with_current(ToString::to_string)
+        .map_err(|err| anyhow!("failed to read log filter: {err}"))?;
+    handle
+        .reload(filter)
+        .map_err(|err| anyhow!("failed to update log filter: {err}"))?;
+    Ok(previous)
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn set_log_filter_rejects_empty_and_malformed_directives() {
+        let empty = set_log_filter("  ").unwrap_err();
+        assert_eq!(empty.to_string(), "log filter must not be empty");
+
+        let malformed = set_log_filter("holon=notalevel").unwrap_err();
+        assert!(malformed.to_string().contains("invalid log filter"));
+    }
+}
@@ -26,13 +26,14 @@ use holon::{
     },
     config::{AgentTemplateRemoteSourceConfigFile, AgentTemplatesConfigFile},
     daemon::{
-        daemon_logs, daemon_restart, daemon_start, daemon_status, daemon_stop,
-        ensure_serve_preflight, prepare_runtime_before_server, RuntimeServiceHandle,
-        DAEMON_SERVE_ARGS_ENV, PRE_SERVER_PREPARED_ENV,
+        daemon_logs_with_filter, daemon_restart, daemon_start, daemon_status, daemon_stop,
+        ensure_serve_preflight, prepare_runtime_before_server, DaemonLogFilter,
+        RuntimeServiceHandle, DAEMON_SERVE_ARGS_ENV, PRE_SERVER_PREPARED_ENV,
     },
     fd_limit::{apply_nofile_limit_policy, DEFAULT_NOFILE_TARGET},
     host::RuntimeHost,
     http::{self, AppState, ControlRequest, CreateCommandTaskRequest, CreateTimerRequest},
+    log_filter::reloadable_log_filter,
     memory::{rebuild_memory_index, request_memory_index_rebuild},
     model_discovery::{discovery_cache_path, refresh_provider_models},
     onboarding::{
@@ -51,7 +52,7 @@ use holon::{
 };
 use tokio::net::TcpListener;
 use tracing::warn;
-use tracing_subscriber::EnvFilter;
+use tracing_subscriber::{layer::SubscriberExt, util::SubscriberInitExt, EnvFilter};
 
 use holon::cli::{
     AgentCommands, AgentModelCommands, Cli, Commands, ConfigCommands, ConfigCredentialCommands,
@@ -74,6 +75,7 @@ async fn main() -> Result<()> {
             create_agent,
             template,
             max_turns,
+            max_duration_ms,
             no_wait_for_tasks,
             home,
             workspace_root,
@@ -87,6 +89,7 @@ async fn main() -> Result<()> {
                 create_agent,
                 template,
                 max_turns,
+                max_duration_ms,
                 no_wait_for_tasks,
                 home,
                 workspace_root,
@@ -104,6 +107,7 @@ async fn main() -> Result<()> {
             template,
             model,
             max_turns,
+            max_duration_ms,
             authority_class,
             json,
             home,
@@ -123,6 +127,7 @@ async fn main() -> Result<()> {
                 template,
                 model,
                 max_turns,
+                max_duration_ms,
                 authority_class,
                 json,
                 home,
@@ -336,6 +341,7 @@ async fn run_one_shot(
     create_agent: bool,
     template: Option<String>,
     max_turns: Option<u64>,
+    max_duration_ms: Option<u64>,
     no_wait_for_tasks: bool,
     home: Option<PathBuf>,
     workspace_root: Option<PathBuf>,
@@ -351,6 +357,7 @@ async fn run_one_shot(
             create_agent,
             template,
             max_turns,
+            max_duration_ms,
             wait_for_tasks: !no_wait_for_tasks,
             workspace_root,
             cwd,
@@ -377,6 +384,7 @@ async fn run_solve_command(
     template: Option<String>,
     model: Option<String>,
     max_turns: Option<u64>,
+    max_duration_ms: Option<u64>,
     authority_class: AuthorityClass,
     json: bool,
     home: Option<PathBuf>,
@@ -400,6 +408,7 @@ async fn run_solve_command(
             agent_id: agent,
             template,
             max_turns,
+            max_duration_ms,
             authority_class,
             json,
             workspace_root,
@@ -1083,6 +1092,7 @@ mod tests {
             max_relevant_episodes: 3,
             control_token: Some("secret".into()),
             control_auth_mode: ControlAuthMode::Auto,
+            webhook_secret: None,
             api_cors: Default::default(),
             config_file_path: home.join("config.json"),
             stored_config: Default::default(),
@@ -2298,6 +2308,14 @@ async fn handle_debug_command(config: AppConfig, command: DebugCommands) -> Resu
             events_limit,
         } => print_latency_diagnostics(&config, agent, limit, events_limit),
         DebugCommands::Performance { json } => print_performance_diagnostics(&config, json).await,
+        DebugCommands::LogFilter { filter } => {
+            let client = LocalClient::new(config.clone())?;
+            let response = match filter {
+                Some(filter) => client.update_runtime_log_filter(&filter).await?,
+                None => client.runtime_log_filter().await?,
+            };
+            print_json(&serde_json::to_value(response)?)
+        }
         DebugCommands::RuntimeDb { command } => handle_runtime_db_debug_command(&config, command),
         DebugCommands::SchedulerFixture { agent, output } => {
             export_scheduler_fixture(&config, agent, &output)
@@ -3020,7 +3038,13 @@ async fn handle_daemon_command(config: AppConfig, command: DaemonCommands) -> Re
                 .await?,
             )?
         }
-        DaemonCommands::Logs { tail } => serde_json::to_value(daemon_logs(&config, tail)?)?,
+        DaemonCommands::Logs { tail, grep, level } => {
+            let filter = DaemonLogFilter {
+                grep,
+                min_level: level,
+            };
+            serde_json::to_value(daemon_logs_with_filter(&config, tail, &filter)?)?
+        }
     };
     print_json(&value)
 }
@@ -4002,8 +4026,8 @@ fn print_json(value: &serde_json::Value) -> Result<()> {
 
 fn init_tracing() {
     let filter = EnvFilter::try_from_default_env().unwrap_or_else(|_| EnvFilter::new("info"));
-    let _ = tracing_subscriber::fmt()
-        .with_env_filter(filter)
-        .with_writer(std::io::stderr)
+    let _ = tracing_subscriber::registry()
+        .with(reloadable_log_filter(filter))
+        .with(tracing_subscriber::fmt::layer().with_writer(std::io::stderr))
         .try_init();
 }
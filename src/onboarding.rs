@@ -1026,6 +1026,7 @@ mod tests {
             max_relevant_episodes: 3,
             control_token: Some("control-value".into()),
             control_auth_mode: ControlAuthMode::Auto,
+            webhook_secret: None,
             api_cors: Default::default(),
             config_file_path: home_dir.join("config.json"),
             stored_config: Default::default(),
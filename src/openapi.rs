@@ -12,7 +12,8 @@ use crate::{
         CancelTimerRequest, CompleteWorkItemRequest, CreateTimerRequest, MemoryGetRequest,
         ModelConfigMigrationRequest, PickWorkItemRequest, PickWorkItemResponse,
         RuntimeConfigReadResponse, RuntimeConfigUpdateRequest, RuntimeConfigUpdateResponse,
-        SearchRequest, SearchResponse, UpdateWorkItemRequest,
+        RuntimeLogFilterResponse, RuntimeLogFilterUpdateRequest, SearchRequest, SearchResponse,
+        UpdateWorkItemRequest,
     },
     http_dto::{AgentStateSnapshotDto, SlimTaskDto, SlimWorkItemDto},
     memory::MemoryGetResult,
@@ -45,6 +46,7 @@ struct RouteSpec {
 enum ResponseKind {
     Json,
     EventStream,
+    PrometheusText,
 }
 
 #[derive(Clone, Copy)]
@@ -119,7 +121,7 @@ const ROUTES: &[RouteSpec] = &[
     route_with_response("post", "/memory/get", "runtimeMemoryGet", "search", "Fetch runtime memory source", "Fetch exact bounded memory content by source_ref, matching the agent MemoryGet tool contract.", Some("MemoryGetRequest"), "MemoryGetResult", AuthKind::RemoteAccess),
     route("post", "/enqueue", "enqueueDefault", "ingress", "Enqueue default agent message", "Enqueue a public channel/webhook message for the default agent.", Some("EnqueueRequest"), AuthKind::RemoteAccess),
     route("post", "/agents/{agent_id}/enqueue", "enqueueAgent", "ingress", "Enqueue agent message", "Enqueue a public channel/webhook message for the named agent.", Some("EnqueueRequest"), AuthKind::RemoteAccess),
-    route("post", "/webhooks/generic/{agent_id}", "genericWebhook", "ingress", "Generic webhook", "Convert an arbitrary JSON webhook body into a trusted integration message.", Some("GenericJsonPayload"), AuthKind::None),
+    route("post", "/webhooks/generic/{agent_id}", "genericWebhook", "ingress", "Generic webhook", "Convert an arbitrary JSON webhook body into a trusted integration message. When HOLON_WEBHOOK_SECRET is set, requires an X-Hub-Signature-256 HMAC of the raw body instead of bearer auth.", Some("GenericJsonPayload"), AuthKind::None),
     route("post", "/control/agents/{agent_id}/tasks", "createCommandTask", "control", "Create command task", "Schedule a command task for an agent.", Some("CreateCommandTaskRequest"), AuthKind::Control),
     route_with_response("post", "/control/agents/{agent_id}/work-items", "createWorkItem", "control", "Create work item", "Create or enqueue a public work item objective.", Some("CreateWorkItemRequest"), "WorkItemRecord", AuthKind::Control),
     route_with_response("post", "/control/agents/{agent_id}/work-items/{work_item_id}/pick", "pickWorkItem", "control", "Pick work item", "Make an existing open work item the current focus for the agent.", Some("PickWorkItemRequest"), "PickWorkItemResponse", AuthKind::Control),
@@ -141,6 +143,9 @@ const ROUTES: &[RouteSpec] = &[
     route("post", "/control/agents/{agent_id}/operator-ingress", "operatorIngress", "control", "Operator ingress", "Deliver an authenticated remote operator prompt.", Some("OperatorIngressRequest"), AuthKind::Control),
     route("get", "/control/runtime/readiness", "runtimeReadiness", "runtime", "Runtime readiness", "Return daemon readiness metadata.", None, AuthKind::Control),
     route("get", "/control/runtime/status", "runtimeStatus", "runtime", "Runtime status", "Return daemon status and runtime activity metadata.", None, AuthKind::Control),
+    prometheus_text_route("get", "/control/runtime/metrics", "runtimeMetrics", "runtime", "Runtime metrics", "Return the runtime performance diagnostics in the Prometheus text exposition format for scraping.", AuthKind::Control),
+    route_with_response("get", "/control/runtime/log-filter", "runtimeLogFilter", "runtime", "Runtime log filter", "Return the active tracing filter directives for the daemon log.", None, "RuntimeLogFilterResponse", AuthKind::Control),
+    route_with_response("patch", "/control/runtime/log-filter", "runtimeLogFilterUpdate", "runtime", "Update runtime log filter", "Replace the daemon tracing filter without restarting the runtime.", Some("RuntimeLogFilterUpdateRequest"), "RuntimeLogFilterResponse", AuthKind::Control),
     route_with_response("get", "/control/runtime/performance", "runtimePerformance", "runtime", "Runtime performance diagnostics", "Return bounded in-process performance diagnostics for HTTP, projections, DB, and scheduler activity.", None, "PerformanceDiagnosticsSnapshot", AuthKind::Control),
     route_with_response("get", "/control/runtime/config", "runtimeConfig", "runtime", "Runtime config", "Return the daemon effective runtime configuration surface.", None, "RuntimeConfigReadResponse", AuthKind::Control),
     route_with_response("patch", "/control/runtime/config", "runtimeConfigUpdate", "runtime", "Update runtime config", "Persist runtime-mutable config updates and classify their effect as restart/reload-required or rejected.", Some("RuntimeConfigUpdateRequest"), "RuntimeConfigUpdateResponse", AuthKind::Control),
@@ -267,6 +272,30 @@ const fn event_stream_route(
     }
 }
 
+const fn prometheus_text_route(
+    method: &'static str,
+    path: &'static str,
+    operation_id: &'static str,
+    tag: &'static str,
+    summary: &'static str,
+    description: &'static str,
+    auth: AuthKind,
+) -> RouteSpec {
+    RouteSpec {
+        method,
+        path,
+        operation_id,
+        tag,
+        summary,
+        description,
+        request_schema: None,
+        response_schema: None,
+        response_kind: ResponseKind::PrometheusText,
+        auth,
+        metadata_source: MetadataSource::Manual,
+    }
+}
+
 pub fn generate_openapi_json() -> Value {
     openapi_value()
 }
@@ -504,6 +533,16 @@ fn responses(kind: ResponseKind, response_schema: Option<&str>) -> Value {
                 "content": { "application/json": { "schema": { "$ref": "#/components/schemas/ErrorResponse" } } }
             }
         }),
+        ResponseKind::PrometheusText => json!({
+            "200": {
+                "description": "Prometheus text exposition format.",
+                "content": { "text/plain": { "schema": { "type": "string" } } }
+            },
+            "4XX": {
+                "description": "Client error JSON response.",
+                "content": { "application/json": { "schema": { "$ref": "#/components/schemas/ErrorResponse" } } }
+            }
+        }),
     }
 }
 
@@ -649,6 +688,14 @@ fn component_schemas() -> Value {
         "RuntimeConfigUpdateResponse".into(),
         component_schema::<RuntimeConfigUpdateResponse>(),
     );
+    schemas.insert(
+        "RuntimeLogFilterUpdateRequest".into(),
+        component_schema::<RuntimeLogFilterUpdateRequest>(),
+    );
+    schemas.insert(
+        "RuntimeLogFilterResponse".into(),
+        component_schema::<RuntimeLogFilterResponse>(),
+    );
     schemas.insert(
         "ModelConfigMigrationRequest".into(),
         component_schema::<ModelConfigMigrationRequest>(),
@@ -158,6 +158,7 @@ mod tests {
             max_relevant_episodes: 3,
             control_token: Some("secret".into()),
             control_auth_mode: ControlAuthMode::Auto,
+            webhook_secret: None,
             api_cors: Default::default(),
             config_file_path: home.join("config.json"),
             stored_config: Default::default(),
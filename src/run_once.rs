@@ -13,14 +13,14 @@ use crate::{
     host::RuntimeHost,
     ingress::InboundRequest,
     provider::ProviderCacheUsage,
-    runtime::RuntimeHandle,
+    runtime::{CurrentRunAbortMode, CurrentRunAbortRequest, RuntimeHandle},
     storage::PollActivityMarker,
     system::{WorkspaceAccessMode, WorkspaceProjectionKind},
     types::{
         AdmissionContext, AgentStatus, AuditEvent, AuthorityClass, ClosureOutcome, ControlAction,
         FailureArtifact, MessageBody, MessageDeliverySurface, MessageEnvelope, MessageKind,
-        MessageOrigin, Priority, TaskOutputSnapshot, TaskRecord, TaskStatus, TokenUsage,
-        ToolExecutionRecord, ToolExecutionStatus, TurnBudget, WaitingReason,
+        MessageOrigin, Priority, QueueEntryStatus, TaskOutputSnapshot, TaskRecord, TaskStatus,
+        TokenUsage, ToolExecutionRecord, ToolExecutionStatus, TurnBudget, WaitingReason,
     },
 };
 
@@ -28,6 +28,7 @@ const RUN_POLL_INTERVAL_MS: u64 = 100;
 const RUN_QUIESCENCE_WINDOW_MS: u64 = 350;
 const RUN_STOP_SETTLE_TIMEOUT_MS: u64 = 2_000;
 const RUN_STOP_SETTLE_MIN_PER_TASK_MS: u64 = 100;
+const RUN_QUEUE_STATUS_SCAN_LIMIT: usize = 64;
 
 #[derive(Debug, Clone)]
 pub struct RunOnceRequest {
@@ -37,6 +38,7 @@ pub struct RunOnceRequest {
     pub create_agent: bool,
     pub template: Option<String>,
     pub max_turns: Option<u64>,
+    pub max_duration_ms: Option<u64>,
     pub wait_for_tasks: bool,
     pub workspace_root: Option<PathBuf>,
     pub cwd: Option<PathBuf>,
@@ -49,6 +51,7 @@ pub enum RunFinalStatus {
     Waiting,
     Failed,
     MaxTurnsExceeded,
+    MaxDurationExceeded,
 }
 
 #[derive(Debug, Clone, Serialize)]
@@ -259,8 +262,13 @@ pub async fn run_once_with_host(
 
     let message = inbound.into_message();
     let queued_message = session.runtime.enqueue(message).await?;
+    let deadline = request
+        .max_duration_ms
+        .map(|max_duration_ms| Instant::now() + Duration::from_millis(max_duration_ms));
 
     let mut candidate_completion: Option<CandidateCompletion> = None;
+    let mut deadline_abort_at: Option<Instant> = None;
+    let mut deadline_aborted_run_id: Option<String> = None;
     let mut observed_new_task_ids = HashSet::<String>::new();
 
     let final_candidate = loop {
@@ -277,6 +285,51 @@ pub async fn run_once_with_host(
         let max_turns_hit = request.max_turns.is_some_and(|max| turns_elapsed >= max);
         let terminal_within_max_turns = request.max_turns.is_none_or(|max| turns_elapsed <= max);
 
+        // The wall-clock deadline preempts quiescence detection. Only a turn
+        // that is processing this run's message is aborted, so a timer wake or
+        // another enqueue on a named agent is left alone. The run finishes
+        // once its message has settled or the stop settle window runs out.
+        if deadline.is_some_and(|deadline| Instant::now() >= deadline) {
+            let abort_at = *deadline_abort_at.get_or_insert_with(Instant::now);
+            let message_status = run_message_queue_status(&session.runtime, &queued_message.id)?;
+            let message_in_flight = matches!(
+                message_status,
+                Some(QueueEntryStatus::Dequeued | QueueEntryStatus::Interjected)
+            );
+            if let Some(run_id) = state.current_run_id.clone().filter(|_| message_in_flight) {
+                if deadline_aborted_run_id.as_deref() != Some(run_id.as_str()) {
+                    // Errors are intentionally swallowed: the turn may settle
+                    // between the state read and the abort, which is already
+                    // the outcome the deadline asks for.
+                    let _ = session
+                        .runtime
+                        .abort_current_run(CurrentRunAbortRequest {
+                            run_id: Some(run_id.clone()),
+                            mode: CurrentRunAbortMode::IdleAfterAbort,
+                            reason: "max_duration_exceeded".into(),
+                        })
+                        .await;
+                    deadline_aborted_run_id = Some(run_id);
+                }
+            }
+            let message_settled = !message_in_flight
+                && !matches!(
+                    message_status,
+                    Some(QueueEntryStatus::Queued | QueueEntryStatus::Interrupted)
+                );
+            if message_settled
+                || abort_at.elapsed() >= Duration::from_millis(RUN_STOP_SETTLE_TIMEOUT_MS)
+            {
+                break CandidateCompletion::new(
+                    RunFinalStatus::MaxDurationExceeded,
+                    None,
+                    poll_view.activity_signature.clone(),
+                );
+            }
+            tokio::time::sleep(Duration::from_millis(RUN_POLL_INTERVAL_MS)).await;
+            continue;
+        }
+
         let terminal_status = if foreground_idle && poll_view.turn_terminal_observed {
             session.runtime.current_closure().await?.map(|closure| {
                 (
@@ -432,6 +485,22 @@ enum RunSessionCleanup {
     Persistent,
 }
 
+/// Returns the latest queue status of the run's message. Only recent entries
+/// are scanned: the message was enqueued by this run, so unless it is among
+/// them it has long since settled.
+fn run_message_queue_status(
+    runtime: &RuntimeHandle,
+    message_id: &str,
+) -> Result<Option<QueueEntryStatus>> {
+    Ok(runtime
+        .storage()
+        .read_recent_queue_entries(RUN_QUEUE_STATUS_SCAN_LIMIT)?
+        .into_iter()
+        .rev()
+        .find(|entry| entry.message_id == message_id)
+        .map(|entry| entry.status))
+}
+
 async fn prepare_run_session(host: &RuntimeHost, request: &RunOnceRequest) -> Result<RunSession> {
     if request.template.is_some() && !request.create_agent {
         bail!("template requires create_agent=true");
@@ -1319,6 +1388,7 @@ fn final_status_label(state: RunFinalStatus) -> &'static str {
         RunFinalStatus::Waiting => "waiting",
         RunFinalStatus::Failed => "failed",
         RunFinalStatus::MaxTurnsExceeded => "max_turns_exceeded",
+        RunFinalStatus::MaxDurationExceeded => "max_duration_exceeded",
     }
 }
 
@@ -787,6 +787,16 @@ fn runtime_error_queue_settlement(
     }
 }
 
+/// Operator aborts leave the message to replay on recovery. A run deadline
+/// abort is final, so its message must not come back on the next load.
+fn aborted_run_queue_settlement(reason: &str) -> QueueEntryStatus {
+    if reason == "max_duration_exceeded" {
+        QueueEntryStatus::Aborted
+    } else {
+        QueueEntryStatus::Interrupted
+    }
+}
+
 #[derive(Debug, Clone)]
 struct AgentRuntimeProjectionCache {
     agent_id: String,
@@ -1062,12 +1072,16 @@ struct CurrentRunAbortHandle {
 #[derive(Debug, Clone, Copy, PartialEq, Eq)]
 pub enum CurrentRunAbortMode {
     StopAfterAbort,
+    /// Cancels the turn but leaves the agent running, so it settles back to
+    /// idle and keeps accepting input.
+    IdleAfterAbort,
 }
 
 impl CurrentRunAbortMode {
     pub fn as_str(self) -> &'static str {
         match self {
             Self::StopAfterAbort => "stop_after_abort",
+            Self::IdleAfterAbort => "idle_after_abort",
         }
     }
 }
@@ -1082,6 +1096,9 @@ impl Default for CurrentRunAbortMode {
 pub struct CurrentRunAbortRequest {
     pub run_id: Option<String>,
     pub mode: CurrentRunAbortMode,
+    /// Recorded as the turn terminal reason and on the `current_run_aborted`
+    /// event, e.g. `operator_aborted`.
+    pub reason: String,
 }
 
 #[derive(Debug, Clone, PartialEq, Eq)]
@@ -1399,11 +1416,13 @@ impl RuntimeHandle {
         }
 
         if let Ok(mut reason) = handle.reason.lock() {
-            *reason = "operator_aborted".into();
+            *reason = request.reason.clone();
         }
         handle.token.cancel();
-        scheduler::apply_stop_projection(&mut guard.state);
-        guard.persist_state(&self.inner.storage)?;
+        if request.mode == CurrentRunAbortMode::StopAfterAbort {
+            scheduler::apply_stop_projection(&mut guard.state);
+            guard.persist_state(&self.inner.storage)?;
+        }
         drop(guard);
 
         self.inner.storage.append_event(&AuditEvent::legacy(
@@ -1412,7 +1431,7 @@ impl RuntimeHandle {
                 "agent_id": agent_id,
                 "run_id": handle.run_id,
                 "mode": request.mode.as_str(),
-                "reason": "operator_aborted",
+                "reason": request.reason,
             }),
         ))?;
         self.inner.notify.notify_waiters();
@@ -2532,7 +2551,7 @@ impl RuntimeHandle {
                             (
                                 self.build_turn_aborted_record(&aborted.reason, None, 0)
                                     .await,
-                                QueueEntryStatus::Interrupted,
+                                aborted_run_queue_settlement(&aborted.reason),
                                 vec![AuditEvent::legacy(
                                     "message_processing_aborted",
                                     serde_json::json!({
@@ -2545,6 +2564,7 @@ impl RuntimeHandle {
                                 None,
                             )
                         } else {
+                            crate::diagnostics::record_turn_failure();
                             let descriptor = describe_runtime_error(&err);
                             let terminal = self
                                 .build_turn_aborted_record("runtime_error", None, 0)
@@ -2714,6 +2734,7 @@ impl RuntimeHandle {
     }
 
     pub(crate) async fn record_runtime_loop_failure(&self, error: &anyhow::Error) {
+        crate::diagnostics::record_runtime_loop_failure();
         let descriptor = describe_runtime_error(error);
         let summary =
             Self::summarize_runtime_failure_error(&anyhow!(descriptor.operator_message.clone()));
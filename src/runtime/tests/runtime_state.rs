@@ -3258,6 +3258,7 @@ async fn interrupted_terminal_fault_rolls_back_queue_canonical_and_turn_facts()
         .abort_current_run(CurrentRunAbortRequest {
             run_id: Some(run_id),
             mode: CurrentRunAbortMode::StopAfterAbort,
+            reason: "operator_aborted".into(),
         })
         .await
         .unwrap();
@@ -6299,6 +6300,7 @@ async fn abort_current_run_aborts_provider_turn_and_stops_agent() {
         .abort_current_run(CurrentRunAbortRequest {
             run_id: Some(run_id.clone()),
             mode: CurrentRunAbortMode::StopAfterAbort,
+            reason: "operator_aborted".into(),
         })
         .await
         .unwrap();
@@ -6817,6 +6819,7 @@ async fn abort_current_run_rejects_stale_run_id() {
         .abort_current_run(CurrentRunAbortRequest {
             run_id: Some("stale-run".into()),
             mode: CurrentRunAbortMode::StopAfterAbort,
+            reason: "operator_aborted".into(),
         })
         .await
         .unwrap_err();
@@ -6832,6 +6835,7 @@ async fn abort_current_run_rejects_stale_run_id() {
         .abort_current_run(CurrentRunAbortRequest {
             run_id: None,
             mode: CurrentRunAbortMode::StopAfterAbort,
+            reason: "operator_aborted".into(),
         })
         .await
         .unwrap();
@@ -26,6 +26,7 @@ pub struct SolveRequest {
     pub agent_id: Option<String>,
     pub template: Option<String>,
     pub max_turns: Option<u64>,
+    pub max_duration_ms: Option<u64>,
     pub authority_class: AuthorityClass,
     pub json: bool,
     pub workspace_root: Option<PathBuf>,
@@ -86,6 +87,7 @@ pub async fn run_solve(config: AppConfig, request: SolveRequest) -> Result<RunOn
         create_agent: true,
         template: Some(template),
         max_turns: request.max_turns,
+        max_duration_ms: request.max_duration_ms,
         wait_for_tasks: true,
         workspace_root: request.workspace_root.clone(),
         cwd: request.cwd.clone(),
@@ -378,6 +380,7 @@ fn write_run_artifacts(
             RunFinalStatus::Waiting => "waiting",
             RunFinalStatus::Failed => "failed",
             RunFinalStatus::MaxTurnsExceeded => "max_turns_exceeded",
+            RunFinalStatus::MaxDurationExceeded => "max_duration_exceeded",
         };
         let outcome = if response.final_status == RunFinalStatus::Completed {
             "success"
@@ -413,6 +416,7 @@ mod tests {
             agent_id: None,
             template: None,
             max_turns: Some(1),
+            max_duration_ms: None,
             authority_class: AuthorityClass::OperatorInstruction,
             json: true,
             workspace_root: None,
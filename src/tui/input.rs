@@ -20,6 +20,7 @@ enum SlashCommand {
     Refresh,
     ClearStatus,
     DebugPrompt,
+    LogFilter,
     Display,
     Abort,
     Agent,
@@ -98,8 +99,9 @@ pub(super) struct SlashCommandSpec {
 }
 
 const DISPLAY_MODE_ARGS: &[&str] = &["info", "verbose", "debug", "3", "4", "5", "reset"];
+const LOG_FILTER_ARGS: &[&str] = &["trace", "debug", "info", "warn", "error"];
 
-const SLASH_COMMAND_SPECS: [SlashCommandSpec; 22] = [
+const SLASH_COMMAND_SPECS: [SlashCommandSpec; 23] = [
     SlashCommandSpec {
         name: "/help",
         description: "show slash command help",
@@ -199,6 +201,15 @@ const SLASH_COMMAND_SPECS: [SlashCommandSpec; 22] = [
         arg_rule: SlashArgRule::None,
         command: SlashCommand::DebugPrompt,
     },
+    SlashCommandSpec {
+        name: "/log-filter",
+        description: "replace the daemon log filter until restart",
+        usage: "/log-filter <filter>",
+        arg_hint: SlashArgHint::Values(LOG_FILTER_ARGS),
+        category: SlashCommandCategory::Debug,
+        arg_rule: SlashArgRule::ExactlyOne,
+        command: SlashCommand::LogFilter,
+    },
     SlashCommandSpec {
         name: "/display",
         description: "set or reset selected agent display mode",
@@ -934,6 +945,23 @@ impl TuiApp {
                 };
                 self.status_line = "Opened debug prompt dialog".into();
             }
+            SlashCommand::LogFilter => {
+                let filter = args
+                    .into_iter()
+                    .next()
+                    .expect("slash command /log-filter requires one argument");
+                let response = self.client.update_runtime_log_filter(&filter).await?;
+                self.overlay = OverlayState::None;
+                self.status_line = match response.previous_filter {
+                    Some(previous) => {
+                        format!(
+                            "Daemon log filter set to {} (was {previous})",
+                            response.filter
+                        )
+                    }
+                    None => format!("Daemon log filter set to {}", response.filter),
+                };
+            }
             SlashCommand::Display => {
                 let level = args
                     .into_iter()
@@ -2843,6 +2871,13 @@ mod tests {
                 vec!["4".into()]
             ))
         );
+        assert_eq!(
+            parse_composer_submission("/log-filter holon=debug,info").unwrap(),
+            Some(ComposerSubmission::Slash(
+                SlashCommand::LogFilter,
+                vec!["holon=debug,info".into()]
+            ))
+        );
         assert_eq!(
             parse_composer_submission("/skill-catalog").unwrap(),
             Some(ComposerSubmission::Slash(
@@ -64,6 +64,7 @@ fn test_config() -> AppConfig {
         max_relevant_episodes: 3,
         control_token: Some("secret".into()),
         control_auth_mode: crate::config::ControlAuthMode::Auto,
+        webhook_secret: None,
         api_cors: Default::default(),
         config_file_path: temp.join("config.json"),
         stored_config: Default::default(),
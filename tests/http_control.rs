@@ -48,6 +48,7 @@ http_async_tests!(
     runtime_status_route_reports_runtime_metadata,
     runtime_readiness_route_omits_activity_summary,
     runtime_config_route_reads_and_updates_persisted_runtime_config,
+    runtime_log_filter_routes_report_unavailable_without_reload_handle,
     cors_preflight_allows_default_localhost_origins,
     cors_preflight_allows_default_put_credentials_route,
     cors_preflight_respects_configured_origin,
@@ -18,4 +18,7 @@ http_async_tests!(
     generic_webhook_and_multi_agent_listing_work,
     public_enqueue_rejects_privileged_origin_and_trust_override,
     generic_webhook_requires_bearer_token_when_configured,
+    generic_webhook_requires_valid_signature_when_secret_configured,
+    generic_webhook_collapses_redelivered_github_deliveries,
+    generic_webhook_enqueues_concurrent_same_delivery_once,
 );
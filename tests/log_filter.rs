@@ -0,0 +1,43 @@
+//! The reload handle is process-global, so these tests live in their own
+//! binary where installing it cannot leak into the other HTTP suites.
+
+use anyhow::Result;
+use holon::{client::LocalClient, log_filter::reloadable_log_filter};
+use tracing_subscriber::{layer::SubscriberExt, EnvFilter};
+
+mod support;
+
+use support::{spawn_server_with_config, test_config};
+
+#[tokio::test]
+async fn local_client_reads_and_updates_reloadable_log_filter() -> Result<()> {
+    let _subscriber =
+        tracing_subscriber::registry().with(reloadable_log_filter(EnvFilter::new("info")));
+
+    let mut config = test_config();
+    let (_host, base, server) = spawn_server_with_config(config.clone()).await?;
+    config.http_addr = base.trim_start_matches("http://").to_string();
+    let client = LocalClient::new(config)?;
+
+    let initial = client.runtime_log_filter().await?;
+    assert!(initial.ok);
+    assert_eq!(initial.filter, "info");
+    assert_eq!(initial.previous_filter, None);
+
+    let updated = client.update_runtime_log_filter("holon=debug,info").await?;
+    assert_eq!(updated.previous_filter.as_deref(), Some("info"));
+    assert!(updated.filter.contains("holon=debug"));
+
+    let reread = client.runtime_log_filter().await?;
+    assert_eq!(reread.filter, updated.filter);
+
+    let err = client
+        .update_runtime_log_filter("holon=notalevel")
+        .await
+        .expect_err("malformed directives should be rejected");
+    assert!(err.to_string().contains("invalid log filter"));
+    assert_eq!(client.runtime_log_filter().await?.filter, updated.filter);
+
+    server.abort();
+    Ok(())
+}
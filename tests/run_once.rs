@@ -11,7 +11,10 @@ use holon::{
     },
     run_once::{run_once_with_host, RunFinalStatus, RunOnceRequest},
     system::{WorkspaceAccessMode, WorkspaceProjectionKind},
-    types::{AuthorityClass, ControlAction, FailureArtifactCategory, TaskStatus, TokenUsage},
+    types::{
+        AgentStatus, AuthorityClass, ControlAction, FailureArtifactCategory, QueueEntryStatus,
+        TaskStatus, TokenUsage,
+    },
 };
 use serde_json::json;
 use tokio::sync::Mutex;
@@ -35,6 +38,7 @@ fn run_request(text: impl Into<String>) -> RunOnceRequest {
         create_agent: false,
         template: None,
         max_turns: None,
+        max_duration_ms: None,
         wait_for_tasks: true,
         workspace_root: None,
         cwd: None,
@@ -1381,6 +1385,138 @@ async fn run_once_max_turns_respects_wait_for_command_tasks() -> Result<()> {
     Ok(())
 }
 
+struct FirstTurnBlockingProvider {
+    calls: Mutex<usize>,
+}
+
+impl FirstTurnBlockingProvider {
+    fn new() -> Self {
+        Self {
+            calls: Mutex::new(0),
+        }
+    }
+}
+
+#[async_trait]
+impl AgentProvider for FirstTurnBlockingProvider {
+    async fn complete_turn(&self, _request: ProviderTurnRequest) -> Result<ProviderTurnResponse> {
+        let call = {
+            let mut calls = self.calls.lock().await;
+            *calls += 1;
+            *calls
+        };
+        if call == 1 {
+            return std::future::pending::<Result<ProviderTurnResponse>>().await;
+        }
+        Ok(ProviderTurnResponse {
+            blocks: vec![ModelBlock::Text {
+                text: "finished after deadline".into(),
+            }],
+            stop_reason: None,
+            input_tokens: 0,
+            output_tokens: 0,
+            cache_usage: None,
+            provider_message_id: None,
+            provider_request_id: None,
+            request_diagnostics: None,
+        })
+    }
+}
+
+#[tokio::test]
+async fn run_once_aborts_turn_when_max_duration_elapses() -> Result<()> {
+    let test_config = test_config();
+    let host = RuntimeHost::new_with_provider(
+        test_config.config().clone(),
+        Arc::new(FirstTurnBlockingProvider::new()),
+    )?;
+
+    let response = tokio::time::timeout(
+        Duration::from_secs(5),
+        run_once_with_host(
+            host.clone(),
+            RunOnceRequest {
+                agent_id: Some("deadline-run".into()),
+                create_agent: true,
+                max_duration_ms: Some(300),
+                ..run_request("never finishes")
+            },
+        ),
+    )
+    .await
+    .expect("max duration should end the run")?;
+
+    assert_eq!(response.final_status, RunFinalStatus::MaxDurationExceeded);
+    assert!(response
+        .render_text()
+        .contains("Run status: max_duration_exceeded"));
+
+    let storage = host.agent_storage("deadline-run")?;
+    let state = storage
+        .read_agent()?
+        .expect("named agent state should be stored");
+    assert_eq!(
+        state
+            .last_turn_terminal
+            .as_ref()
+            .and_then(|terminal| terminal.reason.as_deref()),
+        Some("max_duration_exceeded")
+    );
+    assert!(storage.read_recent_events(usize::MAX)?.iter().any(|event| {
+        event.kind == "current_run_aborted" && event.data["reason"] == "max_duration_exceeded"
+    }));
+    assert!(storage
+        .latest_queue_entries()?
+        .iter()
+        .all(|entry| entry.status != QueueEntryStatus::Interrupted));
+    Ok(())
+}
+
+#[tokio::test]
+async fn run_once_max_duration_leaves_named_agent_usable() -> Result<()> {
+    let test_config = test_config();
+    let host = RuntimeHost::new_with_provider(
+        test_config.config().clone(),
+        Arc::new(FirstTurnBlockingProvider::new()),
+    )?;
+
+    let first = tokio::time::timeout(
+        Duration::from_secs(5),
+        run_once_with_host(
+            host.clone(),
+            RunOnceRequest {
+                agent_id: Some("deadline-agent".into()),
+                create_agent: true,
+                max_duration_ms: Some(300),
+                ..run_request("never finishes")
+            },
+        ),
+    )
+    .await
+    .expect("max duration should end the run")?;
+    assert_eq!(first.final_status, RunFinalStatus::MaxDurationExceeded);
+
+    let state = host
+        .agent_storage("deadline-agent")?
+        .read_agent()?
+        .expect("named agent state should be stored");
+    assert_ne!(state.status, AgentStatus::Stopped);
+    assert_eq!(state.current_run_id, None);
+    assert_eq!(state.pending, 0);
+
+    let second = run_once_with_host(
+        host,
+        RunOnceRequest {
+            agent_id: Some("deadline-agent".into()),
+            ..run_request("try again")
+        },
+    )
+    .await?;
+    assert_eq!(second.final_status, RunFinalStatus::Completed);
+    assert_eq!(second.final_text, "finished after deadline");
+    Ok(())
+}
+
 struct BudgetWarningCheckProvider {
     calls: Mutex<usize>,
 }
@@ -1548,6 +1548,8 @@ pub async fn remote_tcp_surfaces_require_bearer_token_when_required() -> Result<
         "/api",
         "/api/control/runtime/status",
         "/api/control/runtime/config",
+        "/api/control/runtime/metrics",
+        "/api/control/runtime/log-filter",
         "/api/agents/list",
         "/api/agents/default/status",
         "/api/agents/default/state",
@@ -1626,6 +1628,22 @@ pub async fn remote_tcp_surfaces_require_bearer_token_when_required() -> Result<
         .await?;
     assert!(runtime_status.status().is_success());
 
+    let metrics = client
+        .get(format!("{base}/api/control/runtime/metrics"))
+        .bearer_auth("secret")
+        .send()
+        .await?;
+    assert!(metrics.status().is_success());
+    assert!(metrics
+        .headers()
+        .get(reqwest::header::CONTENT_TYPE)
+        .and_then(|value| value.to_str().ok())
+        .is_some_and(|value| value.starts_with("text/plain")));
+    let metrics_text = metrics.text().await?;
+    assert!(metrics_text.contains("# TYPE holon_process_uptime_seconds gauge"));
+    assert!(metrics_text.contains("# TYPE holon_agent_pending_messages gauge"));
+    assert!(metrics_text.contains("holon_http_error_responses_total{class=\"4xx\"} "));
+
     let denied_enqueue = client
         .post(format!("{base}/api/enqueue"))
         .json(&serde_json::json!({ "text": "hello" }))
@@ -2168,6 +2186,57 @@ pub async fn runtime_readiness_route_omits_activity_summary() -> Result<()> {
     Ok(())
 }
 
+pub async fn runtime_log_filter_routes_report_unavailable_without_reload_handle() -> Result<()> {
+    let mut config = test_config();
+    let (_host, base, server) = spawn_server_with_config(config.clone()).await?;
+    config.http_addr = base.trim_start_matches("http://").to_string();
+    let local_client = LocalClient::new(config)?;
+    let client = reqwest::Client::new();
+
+    let read_response = client
+        .get(format!("{base}/api/control/runtime/log-filter"))
+        .bearer_auth("secret")
+        .send()
+        .await?;
+    assert_eq!(
+        read_response.status(),
+        reqwest::StatusCode::SERVICE_UNAVAILABLE
+    );
+    let read_payload: serde_json::Value = read_response.json().await?;
+    assert!(read_payload["error"]
+        .as_str()
+        .unwrap_or_default()
+        .contains("not reloadable"));
+
+    let update_response = client
+        .patch(format!("{base}/api/control/runtime/log-filter"))
+        .bearer_auth("secret")
+        .json(&serde_json::json!({ "filter": "debug" }))
+        .send()
+        .await?;
+    assert_eq!(
+        update_response.status(),
+        reqwest::StatusCode::SERVICE_UNAVAILABLE
+    );
+
+    let misspelled_response = client
+        .patch(format!("{base}/api/control/runtime/log-filter"))
+        .bearer_auth("secret")
+        .json(&serde_json::json!({ "filtr": "debug" }))
+        .send()
+        .await?;
+    assert!(misspelled_response.status().is_client_error());
+
+    let err = local_client
+        .runtime_log_filter()
+        .await
+        .expect_err("log filter should be unavailable without a reloadable subscriber");
+    assert!(err.to_string().contains("not reloadable"));
+
+    server.abort();
+    Ok(())
+}
+
 pub async fn runtime_config_route_reads_and_updates_persisted_runtime_config() -> Result<()> {
     let config = test_config();
     std::fs::create_dir_all(&config.workspace_dir)?;
@@ -38,7 +38,7 @@ use tokio::{
 use super::{
     attach_default_workspace, connect_addr, git, init_git_repo, read_next_sse_event, spawn_server,
     spawn_server_for_host, spawn_server_with_config, spawn_server_with_runtime_config, tempdir,
-    test_config, test_config_with_paths, wait_until, ParsedSseEvent,
+    test_config, test_config_with_paths, wait_until, ParsedSseEvent, TestConfigBuilder,
 };
 
 pub async fn generic_webhook_records_public_admission_fields() -> Result<()> {
@@ -354,3 +354,152 @@ pub async fn generic_webhook_requires_bearer_token_when_configured() -> Result<(
     server.abort();
     Ok(())
 }
+
+pub async fn generic_webhook_collapses_redelivered_github_deliveries() -> Result<()> {
+    let (host, base, server) = spawn_server().await?;
+    let runtime = host.default_runtime().await?;
+    let client = reqwest::Client::new();
+
+    let mut message_ids = Vec::new();
+    for delivery_id in ["delivery-1", "delivery-1", "delivery-2"] {
+        let response = client
+            .post(format!("{base}/api/webhooks/generic/default"))
+            .header("x-github-delivery", delivery_id)
+            .json(&serde_json::json!({ "action": "opened" }))
+            .send()
+            .await?;
+        assert!(response.status().is_success());
+        let payload: serde_json::Value = response.json().await?;
+        message_ids.push(
+            payload["message_id"]
+                .as_str()
+                .unwrap_or_default()
+                .to_string(),
+        );
+    }
+    assert_eq!(message_ids[0], message_ids[1]);
+    assert_ne!(message_ids[0], message_ids[2]);
+
+    let webhook_event_count = || -> Result<usize> {
+        Ok(runtime
+            .storage()
+            .read_recent_messages(10)?
+            .iter()
+            .filter(|message| message.kind == MessageKind::WebhookEvent)
+            .count())
+    };
+    wait_until(|| Ok(webhook_event_count()? >= 2)).await?;
+    assert_eq!(webhook_event_count()?, 2);
+
+    server.abort();
+    Ok(())
+}
+
+pub async fn generic_webhook_enqueues_concurrent_same_delivery_once() -> Result<()> {
+    let (host, base, server) = spawn_server().await?;
+    let runtime = host.default_runtime().await?;
+    let client = reqwest::Client::new();
+    let send = || {
+        client
+            .post(format!("{base}/api/webhooks/generic/default"))
+            .header("x-github-delivery", "delivery-race")
+            .json(&serde_json::json!({ "action": "opened" }))
+            .send()
+    };
+
+    let (first, second) = tokio::join!(send(), send());
+    let mut message_ids = Vec::new();
+    for response in [first?, second?] {
+        let status = response.status();
+        let payload: serde_json::Value = response.json().await?;
+        if status.is_success() {
+            message_ids.push(
+                payload["message_id"]
+                    .as_str()
+                    .unwrap_or_default()
+                    .to_string(),
+            );
+        } else {
+            assert_eq!(status, reqwest::StatusCode::CONFLICT);
+            assert_eq!(payload["code"], "webhook_delivery_in_flight");
+        }
+    }
+    assert!(!message_ids.is_empty());
+    assert!(message_ids
+        .iter()
+        .all(|message_id| message_id == &message_ids[0]));
+
+    let webhook_event_count = || -> Result<usize> {
+        Ok(runtime
+            .storage()
+            .read_recent_messages(10)?
+            .iter()
+            .filter(|message| message.kind == MessageKind::WebhookEvent)
+            .count())
+    };
+    wait_until(|| Ok(webhook_event_count()? >= 1)).await?;
+    assert_eq!(webhook_event_count()?, 1);
+
+    server.abort();
+    Ok(())
+}
+
+pub async fn generic_webhook_requires_valid_signature_when_secret_configured() -> Result<()> {
+    const BODY: &str = r#"{"action":"opened"}"#;
+    const SIGNATURE: &str =
+        "sha256=931f7549cb28864ede02887873140d15dc87d237f31caea0af7e915b292dff26";
+
+    let mut test_config = TestConfigBuilder::new()
+        .with_control_auth_mode(ControlAuthMode::Required)
+        .build();
+    test_config.config_mut().webhook_secret = Some("webhook-secret".into());
+    let (host, base, server) = spawn_server_with_config(test_config.config().clone()).await?;
+    let runtime = host.default_runtime().await?;
+    let client = reqwest::Client::new();
+
+    let unsigned = client
+        .post(format!("{base}/api/webhooks/generic/default"))
+        .bearer_auth("secret")
+        .header("content-type", "application/json")
+        .body(BODY)
+        .send()
+        .await?;
+    assert_eq!(unsigned.status(), reqwest::StatusCode::UNAUTHORIZED);
+    let unsigned_payload: serde_json::Value = unsigned.json().await?;
+    assert_eq!(unsigned_payload["code"], "webhook_signature_invalid");
+
+    let tampered = client
+        .post(format!("{base}/api/webhooks/generic/default"))
+        .header("content-type", "application/json")
+        .header("x-hub-signature-256", SIGNATURE)
+        .body(r#"{"action":"closed"}"#)
+        .send()
+        .await?;
+    assert_eq!(tampered.status(), reqwest::StatusCode::UNAUTHORIZED);
+    assert!(runtime.storage().read_recent_messages(10)?.is_empty());
+
+    let signed = client
+        .post(format!("{base}/api/webhooks/generic/default"))
+        .header("content-type", "application/json")
+        .header("x-hub-signature-256", SIGNATURE)
+        .body(BODY)
+        .send()
+        .await?;
+    assert!(signed.status().is_success());
+
+    wait_until(|| {
+        let messages = runtime.storage().read_recent_messages(10)?;
+        Ok(messages.iter().any(|message| {
+            message.kind == MessageKind::WebhookEvent
+                && message.delivery_surface == Some(MessageDeliverySurface::HttpWebhook)
+                && matches!(
+                    &message.body,
+                    MessageBody::Json { value } if value["action"] == "opened"
+                )
+        }))
+    })
+    .await?;
+
+    server.abort();
+    Ok(())
+}
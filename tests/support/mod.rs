@@ -199,6 +199,7 @@ impl TestConfigBuilder {
             max_relevant_episodes: 3,
             control_token: Some("secret".into()),
             control_auth_mode: self.control_auth_mode,
+            webhook_secret: None,
             api_cors: Default::default(),
             config_file_path: data_dir.join("config.json"),
             stored_config: Default::default(),
@@ -1249,6 +1249,50 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/api/control/runtime/log-filter": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Runtime log filter
+         * @description Return the active tracing filter directives for the daemon log.
+         */
+        get: operations["runtimeLogFilter"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        /**
+         * Update runtime log filter
+         * @description Replace the daemon tracing filter without restarting the runtime.
+         */
+        patch: operations["runtimeLogFilterUpdate"];
+        trace?: never;
+    };
+    "/api/control/runtime/metrics": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Runtime metrics
+         * @description Return the runtime performance diagnostics in the Prometheus text exposition format for scraping.
+         */
+        get: operations["runtimeMetrics"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/api/control/runtime/performance": {
         parameters: {
             query?: never;
@@ -3177,6 +3221,17 @@ export interface components {
                 }[];
             };
         };
+        /** RuntimeLogFilterResponse */
+        RuntimeLogFilterResponse: {
+            filter: string;
+            ok: boolean;
+            previous_filter?: string | null;
+        };
+        /** RuntimeLogFilterUpdateRequest */
+        RuntimeLogFilterUpdateRequest: {
+            /** @description Filter directives in `RUST_LOG` syntax, e.g. `debug` or `holon=debug,info`. */
+            filter: string;
+        };
         /** SearchRequest */
         SearchRequest: {
             /** @default null */
@@ -6739,6 +6794,115 @@ export interface operations {
             };
         };
     };
+    runtimeLogFilter: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Successful JSON response using a stable DTO schema. */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["RuntimeLogFilterResponse"];
+                };
+            };
+            /** @description Client error JSON response. */
+            "4XX": {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ErrorResponse"];
+                };
+            };
+            /** @description Server error JSON response. */
+            "5XX": {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ErrorResponse"];
+                };
+            };
+        };
+    };
+    runtimeLogFilterUpdate: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["RuntimeLogFilterUpdateRequest"];
+            };
+        };
+        responses: {
+            /** @description Successful JSON response using a stable DTO schema. */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["RuntimeLogFilterResponse"];
+                };
+            };
+            /** @description Client error JSON response. */
+            "4XX": {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ErrorResponse"];
+                };
+            };
+            /** @description Server error JSON response. */
+            "5XX": {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ErrorResponse"];
+                };
+            };
+        };
+    };
+    runtimeMetrics: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Prometheus text exposition format. */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "text/plain": string;
+                };
+            };
+            /** @description Client error JSON response. */
+            "4XX": {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ErrorResponse"];
+                };
+            };
+        };
+    };
     runtimePerformance: {
         parameters: {
             query?: never;